@@ -0,0 +1,253 @@
+package ceremony
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("ceremony")
+var zero = big.NewInt(0)
+
+// ArgAssembler represents the assembler constructor argument
+type ArgAssembler struct {
+	SubmissionVerifier       SubmissionVerifier
+	WalletPubKeyConverter    mxCore.PubkeyConverter
+	ValidatorPubKeyConverter mxCore.PubkeyConverter
+	NodePrice                *big.Int
+	TotalSupply              *big.Int
+	OwnerBalance             *big.Int
+	RemainderAddress         string
+	InitialRating            uint32
+}
+
+type assembler struct {
+	submissionVerifier       SubmissionVerifier
+	walletPubKeyConverter    mxCore.PubkeyConverter
+	validatorPubKeyConverter mxCore.PubkeyConverter
+	nodePrice                *big.Int
+	totalSupply              *big.Int
+	ownerBalance             *big.Int
+	remainderAddress         string
+	initialRating            uint32
+}
+
+// NewAssembler will create a new assembler able to build the genesis data out of the operators' submissions
+func NewAssembler(arg ArgAssembler) (*assembler, error) {
+	if check.IfNil(arg.SubmissionVerifier) {
+		return nil, ErrNilSubmissionVerifier
+	}
+	if check.IfNil(arg.WalletPubKeyConverter) {
+		return nil, fmt.Errorf("%w for WalletPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for ValidatorPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if arg.NodePrice == nil {
+		return nil, fmt.Errorf("%w for NodePrice", ErrNilValue)
+	}
+	if arg.TotalSupply == nil {
+		return nil, fmt.Errorf("%w for TotalSupply", ErrNilValue)
+	}
+	if arg.OwnerBalance == nil {
+		return nil, fmt.Errorf("%w for OwnerBalance", ErrNilValue)
+	}
+
+	return &assembler{
+		submissionVerifier:       arg.SubmissionVerifier,
+		walletPubKeyConverter:    arg.WalletPubKeyConverter,
+		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+		nodePrice:                arg.NodePrice,
+		totalSupply:              arg.TotalSupply,
+		ownerBalance:             arg.OwnerBalance,
+		remainderAddress:         arg.RemainderAddress,
+		initialRating:            arg.InitialRating,
+	}, nil
+}
+
+// Assemble will verify all the provided submissions and will build the genesis data out of them. Each owner receives
+// the configured owner balance and the rest of the total supply is credited to the remainder address
+func (a *assembler) Assemble(submissions []*Submission) (*data.GeneratorOutput, error) {
+	if len(submissions) == 0 {
+		return nil, ErrNoSubmissions
+	}
+
+	walletKeys, err := a.verifySubmissions(submissions)
+	if err != nil {
+		return nil, err
+	}
+
+	usedBalance := big.NewInt(0)
+	validatorBlsKeys := make([]*data.BlsKey, 0)
+	for _, key := range walletKeys {
+		key.Balance = big.NewInt(0).Set(a.ownerBalance)
+		usedBalance.Add(usedBalance, key.Balance)
+		usedBalance.Add(usedBalance, key.StakedValue)
+		validatorBlsKeys = append(validatorBlsKeys, key.BlsKeys...)
+	}
+
+	remainder := big.NewInt(0).Sub(a.totalSupply, usedBalance)
+	if remainder.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w, total supply: %s, usedBalance: %s", ErrTotalSupplyTooSmall,
+			a.totalSupply.String(), usedBalance.String())
+	}
+
+	additionalKeys, err := a.creditRemainder(walletKeys, remainder)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("assembled submissions",
+		"num owners", len(walletKeys),
+		"num validators", len(validatorBlsKeys),
+		"remainder", remainder.String(),
+	)
+
+	return &data.GeneratorOutput{
+		ValidatorBlsKeys: validatorBlsKeys,
+		ObserverBlsKeys:  make([]*data.BlsKey, 0),
+		WalletKeys:       walletKeys,
+		AdditionalKeys:   additionalKeys,
+		InitialAccounts:  a.computeInitialAccounts(walletKeys, additionalKeys),
+		InitialNodes:     a.computeInitialNodes(walletKeys),
+	}, nil
+}
+
+func (a *assembler) verifySubmissions(submissions []*Submission) ([]*data.WalletKey, error) {
+	walletKeys := make([]*data.WalletKey, 0, len(submissions))
+	owners := make(map[string]struct{})
+	blsKeys := make(map[string]struct{})
+	for i, submission := range submissions {
+		walletKey, err := a.submissionVerifier.VerifySubmission(submission)
+		if err != nil {
+			return nil, fmt.Errorf("%w for submission at index %d", err, i)
+		}
+
+		_, found := owners[string(walletKey.PubKeyBytes)]
+		if found {
+			return nil, fmt.Errorf("%w %s for submission at index %d", ErrDuplicatedOwner, submission.OwnerAddress, i)
+		}
+		owners[string(walletKey.PubKeyBytes)] = struct{}{}
+
+		for _, blsKey := range walletKey.BlsKeys {
+			_, found = blsKeys[string(blsKey.PubKeyBytes)]
+			if found {
+				pkString, _ := a.validatorPubKeyConverter.Encode(blsKey.PubKeyBytes)
+				return nil, fmt.Errorf("%w %s for submission at index %d", ErrDuplicatedBlsKey, pkString, i)
+			}
+			blsKeys[string(blsKey.PubKeyBytes)] = struct{}{}
+		}
+
+		expectedStake := big.NewInt(0).Mul(a.nodePrice, big.NewInt(int64(len(walletKey.BlsKeys))))
+		if expectedStake.Cmp(walletKey.StakedValue) != 0 {
+			return nil, fmt.Errorf("%w for submission at index %d, requested: %s, expected: %s",
+				ErrInvalidStake, i, walletKey.StakedValue.String(), expectedStake.String())
+		}
+
+		walletKeys = append(walletKeys, walletKey)
+	}
+
+	return walletKeys, nil
+}
+
+// creditRemainder will add the remainder to the owner having the remainder address or will create a new account
+func (a *assembler) creditRemainder(walletKeys []*data.WalletKey, remainder *big.Int) ([]*data.WalletKey, error) {
+	if len(a.remainderAddress) == 0 {
+		if remainder.Cmp(zero) > 0 {
+			return nil, fmt.Errorf("%w for a remainder of %s", ErrMissingRemainderAddress, remainder.String())
+		}
+
+		return make([]*data.WalletKey, 0), nil
+	}
+
+	remainderPkBytes, err := a.walletPubKeyConverter.Decode(a.remainderAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remainder address", err)
+	}
+
+	for _, key := range walletKeys {
+		if bytes.Equal(key.PubKeyBytes, remainderPkBytes) {
+			key.Balance.Add(key.Balance, remainder)
+			return make([]*data.WalletKey, 0), nil
+		}
+	}
+
+	return []*data.WalletKey{
+		{
+			PubKeyBytes: remainderPkBytes,
+			Balance:     big.NewInt(0).Set(remainder),
+		},
+	}, nil
+}
+
+func (a *assembler) computeInitialAccounts(
+	walletKeys []*data.WalletKey,
+	additionalKeys []*data.WalletKey,
+) []mxData.InitialAccount {
+	initialAccounts := make([]mxData.InitialAccount, 0, len(walletKeys)+len(additionalKeys))
+	for _, key := range walletKeys {
+		walletAddress, _ := a.walletPubKeyConverter.Encode(key.PubKeyBytes)
+
+		account := mxData.InitialAccount{
+			Address:      walletAddress,
+			Supply:       big.NewInt(0).Add(key.Balance, key.StakedValue),
+			Balance:      big.NewInt(0).Set(key.Balance),
+			StakingValue: big.NewInt(0).Set(key.StakedValue),
+			Delegation: &mxData.DelegationData{
+				Address: "",
+				Value:   big.NewInt(0),
+			},
+		}
+
+		initialAccounts = append(initialAccounts, account)
+	}
+
+	for _, key := range additionalKeys {
+		walletAddress, _ := a.walletPubKeyConverter.Encode(key.PubKeyBytes)
+
+		account := mxData.InitialAccount{
+			Address:      walletAddress,
+			Supply:       big.NewInt(0).Set(key.Balance),
+			Balance:      big.NewInt(0).Set(key.Balance),
+			StakingValue: big.NewInt(0),
+			Delegation: &mxData.DelegationData{
+				Address: "",
+				Value:   big.NewInt(0),
+			},
+		}
+
+		initialAccounts = append(initialAccounts, account)
+	}
+
+	return initialAccounts
+}
+
+func (a *assembler) computeInitialNodes(walletKeys []*data.WalletKey) []*sharding.InitialNode {
+	initialNodes := make([]*sharding.InitialNode, 0)
+	for _, key := range walletKeys {
+		walletAddress, _ := a.walletPubKeyConverter.Encode(key.PubKeyBytes)
+		for _, blsKey := range key.BlsKeys {
+			validatorPubKey, _ := a.validatorPubKeyConverter.Encode(blsKey.PubKeyBytes)
+
+			initialNodes = append(initialNodes, &sharding.InitialNode{
+				PubKey:        validatorPubKey,
+				Address:       walletAddress,
+				InitialRating: a.initialRating,
+			})
+		}
+	}
+
+	return initialNodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *assembler) IsInterfaceNil() bool {
+	return a == nil
+}
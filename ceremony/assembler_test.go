@@ -0,0 +1,150 @@
+package ceremony
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-deploy-go/check"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const remainderAddress = "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80"
+
+func createMockAssemblerArguments() ArgAssembler {
+	sh, _ := NewSubmissionHandler(createMockSubmissionHandlerArguments())
+	arg := ArgAssembler{
+		SubmissionVerifier: sh,
+		NodePrice:          big.NewInt(2500),
+		TotalSupply:        big.NewInt(20000000),
+		OwnerBalance:       big.NewInt(10),
+		RemainderAddress:   remainderAddress,
+		InitialRating:      50,
+	}
+	arg.WalletPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	arg.ValidatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(96)
+
+	return arg
+}
+
+func createSubmissions(t *testing.T, numValidatorsPerOwner ...int) []*Submission {
+	sh, _ := NewSubmissionHandler(createMockSubmissionHandlerArguments())
+	submissions := make([]*Submission, 0, len(numValidatorsPerOwner))
+	for _, numValidators := range numValidatorsPerOwner {
+		stake := big.NewInt(int64(2500 * numValidators))
+		submissions = append(submissions, createSubmission(t, sh, numValidators, stake))
+	}
+
+	return submissions
+}
+
+func TestNewAssembler_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAssemblerArguments()
+	arg.SubmissionVerifier = nil
+	a, err := NewAssembler(arg)
+	assert.Nil(t, a)
+	assert.Equal(t, ErrNilSubmissionVerifier, err)
+
+	arg = createMockAssemblerArguments()
+	arg.OwnerBalance = nil
+	a, err = NewAssembler(arg)
+	assert.Nil(t, a)
+	assert.True(t, errors.Is(err, ErrNilValue))
+}
+
+func TestAssembler_AssembleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAssemblerArguments()
+	a, _ := NewAssembler(arg)
+
+	output, err := a.Assemble(createSubmissions(t, 1, 3, 2))
+	require.Nil(t, err)
+
+	assert.Equal(t, 6, len(output.ValidatorBlsKeys))
+	assert.Equal(t, 6, len(output.InitialNodes))
+	assert.Equal(t, 0, len(output.ObserverBlsKeys))
+	assert.Equal(t, 3, len(output.WalletKeys))
+	require.Equal(t, 1, len(output.AdditionalKeys))
+	require.Equal(t, 4, len(output.InitialAccounts))
+	for _, node := range output.InitialNodes {
+		assert.Equal(t, arg.InitialRating, node.InitialRating)
+	}
+
+	remainderAccount := output.InitialAccounts[3]
+	assert.Equal(t, remainderAddress, remainderAccount.Address)
+	expectedRemainder := big.NewInt(20000000 - 6*2500 - 3*10)
+	assert.Equal(t, expectedRemainder, remainderAccount.Balance)
+
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(output.InitialAccounts))
+}
+
+func TestAssembler_AssembleRemainderToAnOwnerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	submissions := createSubmissions(t, 1, 1)
+	arg := createMockAssemblerArguments()
+	arg.RemainderAddress = submissions[1].OwnerAddress
+	a, _ := NewAssembler(arg)
+
+	output, err := a.Assemble(submissions)
+	require.Nil(t, err)
+
+	assert.Equal(t, 0, len(output.AdditionalKeys))
+	require.Equal(t, 2, len(output.InitialAccounts))
+	assert.Equal(t, big.NewInt(10), output.InitialAccounts[0].Balance)
+	assert.Equal(t, big.NewInt(20000000-2*2500-10), output.InitialAccounts[1].Balance)
+}
+
+func TestAssembler_AssembleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no submissions", func(t *testing.T) {
+		a, _ := NewAssembler(createMockAssemblerArguments())
+		output, err := a.Assemble(nil)
+		assert.Nil(t, output)
+		assert.Equal(t, ErrNoSubmissions, err)
+	})
+	t.Run("duplicated owner", func(t *testing.T) {
+		submissions := createSubmissions(t, 1)
+		submissions = append(submissions, submissions[0])
+
+		a, _ := NewAssembler(createMockAssemblerArguments())
+		output, err := a.Assemble(submissions)
+		assert.Nil(t, output)
+		assert.True(t, errors.Is(err, ErrDuplicatedOwner))
+	})
+	t.Run("invalid stake", func(t *testing.T) {
+		sh, _ := NewSubmissionHandler(createMockSubmissionHandlerArguments())
+		submissions := []*Submission{createSubmission(t, sh, 2, big.NewInt(2500))}
+
+		a, _ := NewAssembler(createMockAssemblerArguments())
+		output, err := a.Assemble(submissions)
+		assert.Nil(t, output)
+		assert.True(t, errors.Is(err, ErrInvalidStake))
+	})
+	t.Run("total supply too small", func(t *testing.T) {
+		arg := createMockAssemblerArguments()
+		arg.TotalSupply = big.NewInt(5000)
+		a, _ := NewAssembler(arg)
+
+		output, err := a.Assemble(createSubmissions(t, 2))
+		assert.Nil(t, output)
+		assert.True(t, errors.Is(err, ErrTotalSupplyTooSmall))
+	})
+	t.Run("missing remainder address", func(t *testing.T) {
+		arg := createMockAssemblerArguments()
+		arg.RemainderAddress = ""
+		a, _ := NewAssembler(arg)
+
+		output, err := a.Assemble(createSubmissions(t, 1))
+		assert.Nil(t, output)
+		assert.True(t, errors.Is(err, ErrMissingRemainderAddress))
+	})
+}
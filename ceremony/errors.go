@@ -0,0 +1,51 @@
+package ceremony
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPubKeyConverter signals that a nil pub key converter was provided
+var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrNilSubmissionVerifier signals that a nil submission verifier was provided
+var ErrNilSubmissionVerifier = errors.New("nil submission verifier")
+
+// ErrNilValue signals that a nil value was provided
+var ErrNilValue = errors.New("nil value")
+
+// ErrNilSubmission signals that a nil submission was provided
+var ErrNilSubmission = errors.New("nil submission")
+
+// ErrNoSubmissions signals that no submissions were provided
+var ErrNoSubmissions = errors.New("no submissions")
+
+// ErrUnsupportedSubmissionVersion signals that the submission has an unsupported version
+var ErrUnsupportedSubmissionVersion = errors.New("unsupported submission version")
+
+// ErrNoValidators signals that a submission does not contain any validator
+var ErrNoValidators = errors.New("no validators")
+
+// ErrInvalidProofOfPossession signals that the proof-of-possession of a BLS key could not be verified
+var ErrInvalidProofOfPossession = errors.New("invalid proof-of-possession")
+
+// ErrInvalidSignature signals that the owner signature of a submission could not be verified
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrInvalidStake signals that the requested stake does not match the number of submitted validators
+var ErrInvalidStake = errors.New("invalid stake")
+
+// ErrDuplicatedOwner signals that the same owner address was provided in more than one submission
+var ErrDuplicatedOwner = errors.New("duplicated owner")
+
+// ErrDuplicatedBlsKey signals that the same BLS key was provided more than once
+var ErrDuplicatedBlsKey = errors.New("duplicated BLS key")
+
+// ErrTotalSupplyTooSmall signals that the provided total supply is too small
+var ErrTotalSupplyTooSmall = errors.New("total supply is too small")
+
+// ErrMissingRemainderAddress signals that there is a remaining balance but no address to credit it to
+var ErrMissingRemainderAddress = errors.New("missing remainder address")
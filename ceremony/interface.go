@@ -0,0 +1,19 @@
+package ceremony
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+// SubmissionVerifier is able to verify a submission and to extract the owner's data from it
+type SubmissionVerifier interface {
+	VerifySubmission(submission *Submission) (*data.WalletKey, error)
+	IsInterfaceNil() bool
+}
+
+// SubmissionHandler is able to create and to verify submissions
+type SubmissionHandler interface {
+	CreateSubmission(ownerSkBytes []byte, validatorsSkBytes [][]byte, stake *big.Int) (*Submission, error)
+	SubmissionVerifier
+}
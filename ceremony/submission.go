@@ -0,0 +1,41 @@
+package ceremony
+
+import "encoding/json"
+
+// SubmissionVersion is the current version of the submission file format
+const SubmissionVersion = uint32(1)
+
+// SubmittedValidator holds a BLS public key together with its proof-of-possession
+type SubmittedValidator struct {
+	PubKey            string `json:"pubKey"`
+	ProofOfPossession string `json:"proofOfPossession"`
+}
+
+// Submission represents the data an operator provides in order to have its validators included in the genesis.
+// It does not contain any secret, the signature being produced with the owner's wallet key
+type Submission struct {
+	Version      uint32                `json:"version"`
+	OwnerAddress string                `json:"ownerAddress"`
+	Stake        string                `json:"stake"`
+	Validators   []*SubmittedValidator `json:"validators"`
+	Signature    string                `json:"signature"`
+}
+
+// signingMessage returns the bytes signed by the owner: the json representation of the submission without
+// the signature field
+func (s *Submission) signingMessage() ([]byte, error) {
+	unsigned := *s
+	unsigned.Signature = ""
+
+	return json.Marshal(&unsigned)
+}
+
+// proofOfPossessionMessage returns the bytes signed with a BLS key in order to prove its possession. The owner's
+// public key is included so a proof can not be copied into another operator's submission
+func proofOfPossessionMessage(blsPubKey []byte, ownerPubKey []byte) []byte {
+	msg := make([]byte, 0, len(blsPubKey)+len(ownerPubKey))
+	msg = append(msg, blsPubKey...)
+	msg = append(msg, ownerPubKey...)
+
+	return msg
+}
@@ -0,0 +1,235 @@
+package ceremony
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+// ArgSubmissionHandler represents the submission handler constructor argument
+type ArgSubmissionHandler struct {
+	WalletKeyGenerator       crypto.KeyGenerator
+	ValidatorKeyGenerator    crypto.KeyGenerator
+	WalletSigner             crypto.SingleSigner
+	ValidatorSigner          crypto.SingleSigner
+	WalletPubKeyConverter    mxCore.PubkeyConverter
+	ValidatorPubKeyConverter mxCore.PubkeyConverter
+}
+
+type submissionHandler struct {
+	walletKeyGenerator       crypto.KeyGenerator
+	validatorKeyGenerator    crypto.KeyGenerator
+	walletSigner             crypto.SingleSigner
+	validatorSigner          crypto.SingleSigner
+	walletPubKeyConverter    mxCore.PubkeyConverter
+	validatorPubKeyConverter mxCore.PubkeyConverter
+}
+
+// NewSubmissionHandler will create a new submission handler able to create and verify submissions
+func NewSubmissionHandler(arg ArgSubmissionHandler) (*submissionHandler, error) {
+	if check.IfNil(arg.WalletKeyGenerator) {
+		return nil, fmt.Errorf("%w for WalletKeyGenerator", ErrNilKeyGenerator)
+	}
+	if check.IfNil(arg.ValidatorKeyGenerator) {
+		return nil, fmt.Errorf("%w for ValidatorKeyGenerator", ErrNilKeyGenerator)
+	}
+	if check.IfNil(arg.WalletSigner) {
+		return nil, fmt.Errorf("%w for WalletSigner", ErrNilSingleSigner)
+	}
+	if check.IfNil(arg.ValidatorSigner) {
+		return nil, fmt.Errorf("%w for ValidatorSigner", ErrNilSingleSigner)
+	}
+	if check.IfNil(arg.WalletPubKeyConverter) {
+		return nil, fmt.Errorf("%w for WalletPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for ValidatorPubKeyConverter", ErrNilPubKeyConverter)
+	}
+
+	return &submissionHandler{
+		walletKeyGenerator:       arg.WalletKeyGenerator,
+		validatorKeyGenerator:    arg.ValidatorKeyGenerator,
+		walletSigner:             arg.WalletSigner,
+		validatorSigner:          arg.ValidatorSigner,
+		walletPubKeyConverter:    arg.WalletPubKeyConverter,
+		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+	}, nil
+}
+
+// CreateSubmission will create a signed submission for the provided owner and validators secret keys. The secret
+// keys are only used for signing, none of them being part of the resulting submission
+func (sh *submissionHandler) CreateSubmission(
+	ownerSkBytes []byte,
+	validatorsSkBytes [][]byte,
+	stake *big.Int,
+) (*Submission, error) {
+	if stake == nil {
+		return nil, fmt.Errorf("%w for stake", ErrNilValue)
+	}
+	if len(validatorsSkBytes) == 0 {
+		return nil, ErrNoValidators
+	}
+
+	ownerSk, err := sh.walletKeyGenerator.PrivateKeyFromByteArray(ownerSkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the owner secret key", err)
+	}
+	ownerPkBytes, err := ownerSk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, fmt.Errorf("%w for the owner public key", err)
+	}
+	ownerAddress, err := sh.walletPubKeyConverter.Encode(ownerPkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the owner public key", err)
+	}
+
+	submission := &Submission{
+		Version:      SubmissionVersion,
+		OwnerAddress: ownerAddress,
+		Stake:        stake.String(),
+		Validators:   make([]*SubmittedValidator, 0, len(validatorsSkBytes)),
+	}
+
+	for i, skBytes := range validatorsSkBytes {
+		validator, errCreate := sh.createSubmittedValidator(skBytes, ownerPkBytes)
+		if errCreate != nil {
+			return nil, fmt.Errorf("%w for the validator key at index %d", errCreate, i)
+		}
+
+		submission.Validators = append(submission.Validators, validator)
+	}
+
+	msg, err := submission.signingMessage()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := sh.walletSigner.Sign(ownerSk, msg)
+	if err != nil {
+		return nil, err
+	}
+	submission.Signature = hex.EncodeToString(signature)
+
+	return submission, nil
+}
+
+func (sh *submissionHandler) createSubmittedValidator(skBytes []byte, ownerPkBytes []byte) (*SubmittedValidator, error) {
+	sk, err := sh.validatorKeyGenerator.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	pkString, err := sh.validatorPubKeyConverter.Encode(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := sh.validatorSigner.Sign(sk, proofOfPossessionMessage(pkBytes, ownerPkBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubmittedValidator{
+		PubKey:            pkString,
+		ProofOfPossession: hex.EncodeToString(proof),
+	}, nil
+}
+
+// VerifySubmission will check the owner signature and the proof-of-possession of each BLS key. On success, it returns
+// the owner wallet key holding the submitted BLS keys (without any secret key) and the requested stake
+func (sh *submissionHandler) VerifySubmission(submission *Submission) (*data.WalletKey, error) {
+	if submission == nil {
+		return nil, ErrNilSubmission
+	}
+	if submission.Version != SubmissionVersion {
+		return nil, fmt.Errorf("%w %d, supported version: %d",
+			ErrUnsupportedSubmissionVersion, submission.Version, SubmissionVersion)
+	}
+	if len(submission.Validators) == 0 {
+		return nil, ErrNoValidators
+	}
+
+	ownerPkBytes, err := sh.walletPubKeyConverter.Decode(submission.OwnerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the owner address", err)
+	}
+	ownerPk, err := sh.walletKeyGenerator.PublicKeyFromByteArray(ownerPkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the owner address", err)
+	}
+
+	stake, err := core.ConvertToPositiveBigInt(submission.Stake)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the stake", err)
+	}
+
+	signature, err := hex.DecodeString(submission.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the signature", err)
+	}
+	msg, err := submission.signingMessage()
+	if err != nil {
+		return nil, err
+	}
+	err = sh.walletSigner.Verify(ownerPk, msg, signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	blsKeys := make([]*data.BlsKey, 0, len(submission.Validators))
+	for _, validator := range submission.Validators {
+		blsKey, errVerify := sh.verifySubmittedValidator(validator, ownerPkBytes)
+		if errVerify != nil {
+			return nil, errVerify
+		}
+
+		blsKeys = append(blsKeys, blsKey)
+	}
+
+	return &data.WalletKey{
+		PubKeyBytes: ownerPkBytes,
+		BlsKeys:     blsKeys,
+		StakedValue: stake,
+	}, nil
+}
+
+func (sh *submissionHandler) verifySubmittedValidator(validator *SubmittedValidator, ownerPkBytes []byte) (*data.BlsKey, error) {
+	if validator == nil {
+		return nil, fmt.Errorf("%w for validator", ErrNilValue)
+	}
+
+	pkBytes, err := sh.validatorPubKeyConverter.Decode(validator.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for validator %s", err, validator.PubKey)
+	}
+	pk, err := sh.validatorKeyGenerator.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for validator %s", err, validator.PubKey)
+	}
+
+	proof, err := hex.DecodeString(validator.ProofOfPossession)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the proof-of-possession of validator %s", err, validator.PubKey)
+	}
+	err = sh.validatorSigner.Verify(pk, proofOfPossessionMessage(pkBytes, ownerPkBytes), proof)
+	if err != nil {
+		return nil, fmt.Errorf("%w for validator %s: %s", ErrInvalidProofOfPossession, validator.PubKey, err.Error())
+	}
+
+	return &data.BlsKey{
+		PubKeyBytes: pkBytes,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *submissionHandler) IsInterfaceNil() bool {
+	return sh == nil
+}
@@ -0,0 +1,161 @@
+package ceremony
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	edSingleSig "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	blsSingleSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSubmissionHandlerArguments() ArgSubmissionHandler {
+	arg := ArgSubmissionHandler{
+		WalletKeyGenerator:    signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorKeyGenerator: signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		WalletSigner:          &edSingleSig.Ed25519Signer{},
+		ValidatorSigner:       blsSingleSig.NewBlsSigner(),
+	}
+	arg.WalletPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	arg.ValidatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(96)
+
+	return arg
+}
+
+func generateSecretKeys(t *testing.T, arg ArgSubmissionHandler, numValidators int) ([]byte, [][]byte) {
+	ownerSk, _ := arg.WalletKeyGenerator.GeneratePair()
+	ownerSkBytes, err := ownerSk.ToByteArray()
+	require.Nil(t, err)
+
+	validatorsSkBytes := make([][]byte, 0, numValidators)
+	for i := 0; i < numValidators; i++ {
+		sk, _ := arg.ValidatorKeyGenerator.GeneratePair()
+		skBytes, errConvert := sk.ToByteArray()
+		require.Nil(t, errConvert)
+
+		validatorsSkBytes = append(validatorsSkBytes, skBytes)
+	}
+
+	return ownerSkBytes, validatorsSkBytes
+}
+
+func createSubmission(t *testing.T, sh *submissionHandler, numValidators int, stake *big.Int) *Submission {
+	ownerSkBytes, validatorsSkBytes := generateSecretKeys(t, createMockSubmissionHandlerArguments(), numValidators)
+	submission, err := sh.CreateSubmission(ownerSkBytes, validatorsSkBytes, stake)
+	require.Nil(t, err)
+
+	return submission
+}
+
+func TestNewSubmissionHandler_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockSubmissionHandlerArguments()
+	arg.WalletKeyGenerator = nil
+	sh, err := NewSubmissionHandler(arg)
+	assert.Nil(t, sh)
+	assert.True(t, errors.Is(err, ErrNilKeyGenerator))
+
+	arg = createMockSubmissionHandlerArguments()
+	arg.ValidatorSigner = nil
+	sh, err = NewSubmissionHandler(arg)
+	assert.Nil(t, sh)
+	assert.True(t, errors.Is(err, ErrNilSingleSigner))
+
+	arg = createMockSubmissionHandlerArguments()
+	arg.ValidatorPubKeyConverter = nil
+	sh, err = NewSubmissionHandler(arg)
+	assert.Nil(t, sh)
+	assert.True(t, errors.Is(err, ErrNilPubKeyConverter))
+}
+
+func TestSubmissionHandler_CreateAndVerifySubmissionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockSubmissionHandlerArguments()
+	sh, _ := NewSubmissionHandler(arg)
+
+	ownerSkBytes, validatorsSkBytes := generateSecretKeys(t, arg, 3)
+	submission, err := sh.CreateSubmission(ownerSkBytes, validatorsSkBytes, big.NewInt(7500))
+	require.Nil(t, err)
+	assert.Equal(t, SubmissionVersion, submission.Version)
+	assert.Equal(t, "7500", submission.Stake)
+	assert.Equal(t, 3, len(submission.Validators))
+
+	walletKey, err := sh.VerifySubmission(submission)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(7500), walletKey.StakedValue)
+	assert.Empty(t, walletKey.PrivKeyBytes)
+	require.Equal(t, 3, len(walletKey.BlsKeys))
+	for i, blsKey := range walletKey.BlsKeys {
+		sk, _ := arg.ValidatorKeyGenerator.PrivateKeyFromByteArray(validatorsSkBytes[i])
+		expectedPkBytes, _ := sk.GeneratePublic().ToByteArray()
+		assert.Equal(t, expectedPkBytes, blsKey.PubKeyBytes)
+		assert.Empty(t, blsKey.PrivKeyBytes)
+	}
+}
+
+func TestSubmissionHandler_VerifySubmissionTamperedFieldsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSubmissionHandler(createMockSubmissionHandlerArguments())
+
+	t.Run("stake changed", func(t *testing.T) {
+		submission := createSubmission(t, sh, 2, big.NewInt(5000))
+		submission.Stake = "10000"
+
+		walletKey, err := sh.VerifySubmission(submission)
+		assert.Nil(t, walletKey)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+	t.Run("validator removed", func(t *testing.T) {
+		submission := createSubmission(t, sh, 2, big.NewInt(5000))
+		submission.Validators = submission.Validators[:1]
+
+		walletKey, err := sh.VerifySubmission(submission)
+		assert.Nil(t, walletKey)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		submission := createSubmission(t, sh, 1, big.NewInt(2500))
+		submission.Version = SubmissionVersion + 1
+
+		walletKey, err := sh.VerifySubmission(submission)
+		assert.Nil(t, walletKey)
+		assert.True(t, errors.Is(err, ErrUnsupportedSubmissionVersion))
+	})
+}
+
+func TestSubmissionHandler_VerifySubmissionCopiedValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockSubmissionHandlerArguments()
+	sh, _ := NewSubmissionHandler(arg)
+
+	victim := createSubmission(t, sh, 1, big.NewInt(2500))
+
+	// an attacker re-signs the victim's BLS key and proof-of-possession with its own wallet key
+	attackerSk, _ := arg.WalletKeyGenerator.GeneratePair()
+	attackerPkBytes, _ := attackerSk.GeneratePublic().ToByteArray()
+	attackerAddress, _ := arg.WalletPubKeyConverter.Encode(attackerPkBytes)
+	copied := &Submission{
+		Version:      SubmissionVersion,
+		OwnerAddress: attackerAddress,
+		Stake:        victim.Stake,
+		Validators:   victim.Validators,
+	}
+	msg, _ := copied.signingMessage()
+	signature, _ := arg.WalletSigner.Sign(attackerSk, msg)
+	copied.Signature = hex.EncodeToString(signature)
+
+	walletKey, err := sh.VerifySubmission(copied)
+	assert.Nil(t, walletKey)
+	assert.True(t, errors.Is(err, ErrInvalidProofOfPossession))
+}
@@ -14,7 +14,7 @@ var ErrEmptyInitialAccounts = errors.New("empty initial accounts list")
 // ErrSupplyMismatch signals that the initial account's supply mismatches between the computed value and generated value
 var ErrSupplyMismatch = errors.New("supply mismatch")
 
-// ErrStakingValueError signals the provided staking value is not a multiple of the node's price
+// ErrStakingValueError signals the provided staking value does not cover the node's price
 var ErrStakingValueError = errors.New("staking value error")
 
 // ErrNegativeValue signals that the provided value is negative
@@ -25,3 +25,24 @@ var ErrDelegationValues = errors.New("delegation values error")
 
 // ErrTotalSupplyMismatch signals that the total supply mismatches between the computed value and generated value
 var ErrTotalSupplyMismatch = errors.New("total supply mismatch")
+
+// ErrDuplicatedAddress signals that the same address was found in more than one initial account
+var ErrDuplicatedAddress = errors.New("duplicated address")
+
+// ErrNilPubKeyConverter signals that a nil public key converter was provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrDuplicatedBlsKey signals that the same BLS key was found more than once
+var ErrDuplicatedBlsKey = errors.New("duplicated BLS key")
+
+// ErrUndecodableString signals that an address or a public key could not be decoded
+var ErrUndecodableString = errors.New("undecodable string")
+
+// ErrInsufficientStake signals that the stake or the delegation of an address does not cover the price of its nodes
+var ErrInsufficientStake = errors.New("insufficient stake")
+
+// ErrStakeWithoutNodes signals that an address stakes without holding any initial node
+var ErrStakeWithoutNodes = errors.New("stake without nodes")
+
+// ErrDelegationWithoutNodes signals that a value is delegated to an address not holding any initial node
+var ErrDelegationWithoutNodes = errors.New("delegation without nodes")
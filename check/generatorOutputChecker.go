@@ -0,0 +1,237 @@
+package check
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	mxCheck "github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+// ArgGeneratorOutputChecker is the argument used to create a generator output checker
+type ArgGeneratorOutputChecker struct {
+	NodePrice                *big.Int
+	WalletPubKeyConverter    core.PubkeyConverter
+	ValidatorPubKeyConverter core.PubkeyConverter
+}
+
+type generatorOutputChecker struct {
+	nodePrice                *big.Int
+	walletPubKeyConverter    core.PubkeyConverter
+	validatorPubKeyConverter core.PubkeyConverter
+}
+
+type nodesOwner struct {
+	numNodes  int64
+	staked    *big.Int
+	delegated *big.Int
+}
+
+// NewGeneratorOutputChecker creates a new checker reconciling the initial nodes with the initial accounts
+func NewGeneratorOutputChecker(arg ArgGeneratorOutputChecker) (*generatorOutputChecker, error) {
+	if arg.NodePrice == nil {
+		return nil, fmt.Errorf("%w for nodePrice", ErrNilValue)
+	}
+	if arg.NodePrice.Cmp(zero) <= 0 {
+		return nil, fmt.Errorf("%w for nodePrice", ErrZeroOrNegative)
+	}
+	if mxCheck.IfNil(arg.WalletPubKeyConverter) {
+		return nil, fmt.Errorf("%w for the WalletPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if mxCheck.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for the ValidatorPubKeyConverter", ErrNilPubKeyConverter)
+	}
+
+	return &generatorOutputChecker{
+		nodePrice:                arg.NodePrice,
+		walletPubKeyConverter:    arg.WalletPubKeyConverter,
+		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+	}, nil
+}
+
+// CheckGeneratorOutput will check that the nodes setup and the genesis accounts from the provided output are
+// consistent, returning a *ReportError holding all the violations
+func (goc *generatorOutputChecker) CheckGeneratorOutput(output *data.GeneratorOutput) error {
+	return goc.ValidateGeneratorOutput(output).Err()
+}
+
+// ValidateGeneratorOutput will check that the nodes setup and the genesis accounts from the provided output are
+// consistent: every address holding initial nodes has to stake, directly or through delegation, at least the price
+// of its nodes, no stake or delegation can target an address without nodes, and all the keys and addresses have to be
+// unique and decodable. All the violations are collected in the returned report
+func (goc *generatorOutputChecker) ValidateGeneratorOutput(output *data.GeneratorOutput) *Report {
+	report := NewReport()
+	if output == nil {
+		report.addViolation(ErrNilValue, Violation{
+			Field: "GeneratorOutput",
+		})
+		return report
+	}
+
+	goc.checkBlsKeys(output, report)
+	owners, ownersAddresses := goc.computeNodesOwners(output, report)
+	goc.addAccountsStake(output, owners, report)
+
+	for _, address := range ownersAddresses {
+		owner := owners[address]
+		required := big.NewInt(0).Mul(goc.nodePrice, big.NewInt(owner.numNodes))
+		if owner.staked.Cmp(required) >= 0 || owner.delegated.Cmp(required) >= 0 {
+			continue
+		}
+
+		report.addViolation(ErrInsufficientStake, Violation{
+			Address:  address,
+			Field:    "StakingValue",
+			Expected: fmt.Sprintf(">= %s for %d nodes", required.String(), owner.numNodes),
+			Actual:   fmt.Sprintf("staked: %s, delegated: %s", owner.staked.String(), owner.delegated.String()),
+		})
+	}
+
+	return report
+}
+
+func (goc *generatorOutputChecker) checkBlsKeys(output *data.GeneratorOutput, report *Report) {
+	blsKeys := make(map[string]struct{}, len(output.ValidatorBlsKeys)+len(output.ObserverBlsKeys))
+	allBlsKeys := append(append(make([]*data.BlsKey, 0), output.ValidatorBlsKeys...), output.ObserverBlsKeys...)
+	for i, key := range allBlsKeys {
+		_, found := blsKeys[string(key.PubKeyBytes)]
+		if found {
+			encodedKey, _ := goc.validatorPubKeyConverter.Encode(key.PubKeyBytes)
+			report.addViolation(ErrDuplicatedBlsKey, Violation{
+				Key:    encodedKey,
+				Field:  "BlsKeys",
+				Actual: fmt.Sprintf("generated key at index %d", i),
+			})
+		}
+		blsKeys[string(key.PubKeyBytes)] = struct{}{}
+	}
+}
+
+// computeNodesOwners returns the owners of the initial nodes together with their addresses, in the nodes order
+func (goc *generatorOutputChecker) computeNodesOwners(
+	output *data.GeneratorOutput,
+	report *Report,
+) (map[string]*nodesOwner, []string) {
+	owners := make(map[string]*nodesOwner)
+	ownersAddresses := make([]string, 0)
+	nodesPubKeys := make(map[string]struct{}, len(output.InitialNodes))
+	for i, node := range output.InitialNodes {
+		_, found := nodesPubKeys[node.PubKey]
+		if found {
+			report.addViolation(ErrDuplicatedBlsKey, Violation{
+				Address: node.Address,
+				Key:     node.PubKey,
+				Field:   "InitialNodes.PubKey",
+				Actual:  fmt.Sprintf("initial node at index %d", i),
+			})
+		}
+		nodesPubKeys[node.PubKey] = struct{}{}
+
+		_, err := goc.validatorPubKeyConverter.Decode(node.PubKey)
+		if err != nil {
+			report.addViolation(ErrUndecodableString, Violation{
+				Address: node.Address,
+				Key:     node.PubKey,
+				Field:   "InitialNodes.PubKey",
+				Actual:  err.Error(),
+			})
+		}
+		_, err = goc.walletPubKeyConverter.Decode(node.Address)
+		if err != nil {
+			report.addViolation(ErrUndecodableString, Violation{
+				Address: node.Address,
+				Key:     node.PubKey,
+				Field:   "InitialNodes.Address",
+				Actual:  err.Error(),
+			})
+		}
+
+		owner, found := owners[node.Address]
+		if !found {
+			owner = &nodesOwner{
+				staked:    big.NewInt(0),
+				delegated: big.NewInt(0),
+			}
+			owners[node.Address] = owner
+			ownersAddresses = append(ownersAddresses, node.Address)
+		}
+		owner.numNodes++
+	}
+
+	return owners, ownersAddresses
+}
+
+func (goc *generatorOutputChecker) addAccountsStake(
+	output *data.GeneratorOutput,
+	owners map[string]*nodesOwner,
+	report *Report,
+) {
+	addresses := make(map[string]struct{}, len(output.InitialAccounts))
+	for _, ia := range output.InitialAccounts {
+		_, found := addresses[ia.Address]
+		if found {
+			report.addViolation(ErrDuplicatedAddress, Violation{
+				Address: ia.Address,
+				Field:   "Address",
+			})
+		}
+		addresses[ia.Address] = struct{}{}
+
+		_, err := goc.walletPubKeyConverter.Decode(ia.Address)
+		if err != nil {
+			report.addViolation(ErrUndecodableString, Violation{
+				Address: ia.Address,
+				Field:   "Address",
+				Actual:  err.Error(),
+			})
+		}
+
+		if ia.StakingValue != nil && ia.StakingValue.Cmp(zero) > 0 {
+			owner, isNodesOwner := owners[ia.Address]
+			if isNodesOwner {
+				owner.staked.Add(owner.staked, ia.StakingValue)
+			} else {
+				report.addViolation(ErrStakeWithoutNodes, Violation{
+					Address:  ia.Address,
+					Field:    "StakingValue",
+					Expected: "0",
+					Actual:   ia.StakingValue.String(),
+				})
+			}
+		}
+
+		if ia.Delegation == nil || len(ia.Delegation.Address) == 0 {
+			continue
+		}
+		_, err = goc.walletPubKeyConverter.Decode(ia.Delegation.Address)
+		if err != nil {
+			report.addViolation(ErrUndecodableString, Violation{
+				Address: ia.Address,
+				Field:   "Delegation.Address",
+				Actual:  err.Error(),
+			})
+			continue
+		}
+		if ia.Delegation.Value == nil || ia.Delegation.Value.Cmp(zero) <= 0 {
+			continue
+		}
+
+		owner, isNodesOwner := owners[ia.Delegation.Address]
+		if !isNodesOwner {
+			report.addViolation(ErrDelegationWithoutNodes, Violation{
+				Address:  ia.Address,
+				Field:    "Delegation.Address",
+				Expected: "an address holding initial nodes",
+				Actual:   ia.Delegation.Address,
+			})
+			continue
+		}
+		owner.delegated.Add(owner.delegated, ia.Delegation.Value)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (goc *generatorOutputChecker) IsInterfaceNil() bool {
+	return goc == nil
+}
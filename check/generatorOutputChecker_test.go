@@ -0,0 +1,255 @@
+package check
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgGeneratorOutputChecker() ArgGeneratorOutputChecker {
+	arg := ArgGeneratorOutputChecker{
+		NodePrice: big.NewInt(2500),
+	}
+	arg.WalletPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	arg.ValidatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(96)
+
+	return arg
+}
+
+func createTestAddress(arg ArgGeneratorOutputChecker, id byte) string {
+	address, _ := arg.WalletPubKeyConverter.Encode(bytes.Repeat([]byte{id}, 32))
+
+	return address
+}
+
+func createTestBlsKey(id byte) *data.BlsKey {
+	return &data.BlsKey{
+		PubKeyBytes: bytes.Repeat([]byte{id}, 96),
+	}
+}
+
+func createTestAccount(address string, staked int64, delegationAddress string, delegated int64) mxData.InitialAccount {
+	return mxData.InitialAccount{
+		Address:      address,
+		Supply:       big.NewInt(staked + delegated + 1),
+		Balance:      big.NewInt(1),
+		StakingValue: big.NewInt(staked),
+		Delegation: &mxData.DelegationData{
+			Address: delegationAddress,
+			Value:   big.NewInt(delegated),
+		},
+	}
+}
+
+// createTestGeneratorOutput creates an output in which the owner 1 directly stakes 2 nodes and the delegation address 2
+// holds 1 node, covered by the delegators 3 and 4
+func createTestGeneratorOutput(arg ArgGeneratorOutputChecker) *data.GeneratorOutput {
+	output := &data.GeneratorOutput{
+		ValidatorBlsKeys: []*data.BlsKey{createTestBlsKey(1), createTestBlsKey(2), createTestBlsKey(3)},
+		ObserverBlsKeys:  []*data.BlsKey{createTestBlsKey(4)},
+		InitialAccounts: []mxData.InitialAccount{
+			createTestAccount(createTestAddress(arg, 1), 5100, "", 0),
+			createTestAccount(createTestAddress(arg, 3), 0, createTestAddress(arg, 2), 1000),
+			createTestAccount(createTestAddress(arg, 4), 0, createTestAddress(arg, 2), 1500),
+		},
+	}
+	for i, owner := range []byte{1, 1, 2} {
+		pubKey, _ := arg.ValidatorPubKeyConverter.Encode(output.ValidatorBlsKeys[i].PubKeyBytes)
+		output.InitialNodes = append(output.InitialNodes, &sharding.InitialNode{
+			PubKey:  pubKey,
+			Address: createTestAddress(arg, owner),
+		})
+	}
+
+	return output
+}
+
+func TestNewGeneratorOutputChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil node price should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgGeneratorOutputChecker()
+		arg.NodePrice = nil
+		goc, err := NewGeneratorOutputChecker(arg)
+		assert.Nil(t, goc)
+		assert.True(t, errors.Is(err, ErrNilValue))
+	})
+	t.Run("zero node price should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgGeneratorOutputChecker()
+		arg.NodePrice = big.NewInt(0)
+		goc, err := NewGeneratorOutputChecker(arg)
+		assert.Nil(t, goc)
+		assert.True(t, errors.Is(err, ErrZeroOrNegative))
+	})
+	t.Run("nil wallet converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgGeneratorOutputChecker()
+		arg.WalletPubKeyConverter = nil
+		goc, err := NewGeneratorOutputChecker(arg)
+		assert.Nil(t, goc)
+		assert.True(t, errors.Is(err, ErrNilPubKeyConverter))
+	})
+	t.Run("nil validator converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgGeneratorOutputChecker()
+		arg.ValidatorPubKeyConverter = nil
+		goc, err := NewGeneratorOutputChecker(arg)
+		assert.Nil(t, goc)
+		assert.True(t, errors.Is(err, ErrNilPubKeyConverter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		goc, err := NewGeneratorOutputChecker(createMockArgGeneratorOutputChecker())
+		assert.Nil(t, err)
+		assert.False(t, goc.IsInterfaceNil())
+	})
+}
+
+func TestGeneratorOutputChecker_CheckGeneratorOutput(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGeneratorOutputChecker()
+	goc, _ := NewGeneratorOutputChecker(arg)
+
+	t.Run("nil output should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := goc.CheckGeneratorOutput(nil)
+		assert.True(t, errors.Is(err, ErrNilValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := goc.CheckGeneratorOutput(createTestGeneratorOutput(arg))
+		assert.Nil(t, err)
+	})
+	t.Run("duplicated generated BLS key should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.ObserverBlsKeys = append(output.ObserverBlsKeys, createTestBlsKey(2))
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrDuplicatedBlsKey))
+	})
+	t.Run("duplicated initial node should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.InitialNodes[1].PubKey = output.InitialNodes[0].PubKey
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrDuplicatedBlsKey))
+	})
+	t.Run("duplicated address should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.InitialAccounts[2].Address = output.InitialAccounts[1].Address
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrDuplicatedAddress))
+	})
+	t.Run("undecodable strings should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.InitialNodes[0].PubKey = "not hex"
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrUndecodableString))
+
+		output = createTestGeneratorOutput(arg)
+		output.InitialNodes[2].Address = "erd1invalid"
+		err = goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrUndecodableString))
+
+		output = createTestGeneratorOutput(arg)
+		output.InitialAccounts[0].Address = "erd1invalid"
+		err = goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrUndecodableString))
+
+		output = createTestGeneratorOutput(arg)
+		output.InitialAccounts[1].Delegation.Address = "erd1invalid"
+		err = goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrUndecodableString))
+	})
+	t.Run("insufficient direct stake should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.InitialAccounts[0].StakingValue = big.NewInt(4999)
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrInsufficientStake))
+	})
+	t.Run("insufficient delegation should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.InitialAccounts[2].Delegation.Value = big.NewInt(1499)
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrInsufficientStake))
+	})
+	t.Run("stake without nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.InitialAccounts[1].StakingValue = big.NewInt(2500)
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrStakeWithoutNodes))
+	})
+	t.Run("delegation without nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := createTestGeneratorOutput(arg)
+		output.InitialAccounts[1].Delegation.Address = createTestAddress(arg, 5)
+		err := goc.CheckGeneratorOutput(output)
+		assert.True(t, errors.Is(err, ErrDelegationWithoutNodes))
+	})
+}
+
+func TestGeneratorOutputChecker_CheckGeneratorOutputWithTopUpShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGeneratorOutputChecker()
+	goc, _ := NewGeneratorOutputChecker(arg)
+	output := createTestGeneratorOutput(arg)
+	output.InitialAccounts[0].StakingValue = big.NewInt(100000)
+
+	require.Nil(t, goc.CheckGeneratorOutput(output))
+}
+
+func TestGeneratorOutputChecker_ValidateGeneratorOutputShouldCollectAllViolations(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGeneratorOutputChecker()
+	goc, _ := NewGeneratorOutputChecker(arg)
+	output := createTestGeneratorOutput(arg)
+	output.ObserverBlsKeys = append(output.ObserverBlsKeys, createTestBlsKey(2))
+	output.InitialAccounts[0].StakingValue = big.NewInt(4999)
+	output.InitialAccounts[1].StakingValue = big.NewInt(2500)
+	output.InitialAccounts[2].Delegation.Value = big.NewInt(1499)
+
+	report := goc.ValidateGeneratorOutput(output)
+	require.Equal(t, 4, len(report.Violations))
+	assert.True(t, errors.Is(report.Violations[0], ErrDuplicatedBlsKey))
+	assert.True(t, errors.Is(report.Violations[1], ErrStakeWithoutNodes))
+	assert.Equal(t, createTestAddress(arg, 3), report.Violations[1].Address)
+	assert.True(t, errors.Is(report.Violations[2], ErrInsufficientStake))
+	assert.Equal(t, createTestAddress(arg, 1), report.Violations[2].Address)
+	assert.Equal(t, ">= 5000 for 2 nodes", report.Violations[2].Expected)
+	assert.Equal(t, "staked: 4999, delegated: 0", report.Violations[2].Actual)
+	assert.True(t, errors.Is(report.Violations[3], ErrInsufficientStake))
+	assert.Equal(t, createTestAddress(arg, 2), report.Violations[3].Address)
+}
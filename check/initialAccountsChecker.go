@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/multiversx/mx-chain-deploy-go/core"
 	"github.com/multiversx/mx-chain-go/genesis/data"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -12,12 +13,17 @@ var log = logger.GetOrCreate("checking")
 var zero = big.NewInt(0)
 
 type initialAccountsChecker struct {
-	nodePrice   *big.Int
-	totalSupply *big.Int
+	nodePrice    *big.Int
+	totalSupply  *big.Int
+	denomination int
 }
 
-// NewInitialAccountsChecker creates a new initial accounts checker
-func NewInitialAccountsChecker(nodePrice *big.Int, totalSupply *big.Int) (*initialAccountsChecker, error) {
+// NewInitialAccountsChecker creates a new initial accounts checker. The denomination is used when logging the amounts
+func NewInitialAccountsChecker(
+	nodePrice *big.Int,
+	totalSupply *big.Int,
+	denomination int,
+) (*initialAccountsChecker, error) {
 	if nodePrice == nil {
 		return nil, fmt.Errorf("%w for nodePrice", ErrNilValue)
 	}
@@ -30,73 +36,139 @@ func NewInitialAccountsChecker(nodePrice *big.Int, totalSupply *big.Int) (*initi
 	if totalSupply.Cmp(zero) <= 0 {
 		return nil, fmt.Errorf("%w for totalSupply", ErrZeroOrNegative)
 	}
+	if denomination < 0 {
+		return nil, fmt.Errorf("%w for denomination", ErrNegativeValue)
+	}
 
 	return &initialAccountsChecker{
-		nodePrice:   nodePrice,
-		totalSupply: totalSupply,
+		nodePrice:    nodePrice,
+		totalSupply:  totalSupply,
+		denomination: denomination,
 	}, nil
 }
 
-// CheckInitialAccounts will check the provided initial accounts
+// CheckInitialAccounts will check the provided initial accounts, returning a *ReportError holding all the violations
 func (iac *initialAccountsChecker) CheckInitialAccounts(initialAccounts []data.InitialAccount) error {
+	return iac.ValidateInitialAccounts(initialAccounts).Err()
+}
+
+// ValidateInitialAccounts will check the provided initial accounts, collecting all the violations in the returned report
+func (iac *initialAccountsChecker) ValidateInitialAccounts(initialAccounts []data.InitialAccount) *Report {
+	report := NewReport()
 	if len(initialAccounts) == 0 {
-		return ErrEmptyInitialAccounts
+		report.addViolation(ErrEmptyInitialAccounts, Violation{})
+		return report
 	}
 
 	totalSupply := big.NewInt(0)
 	totalStaked := big.NewInt(0)
 	totalBalance := big.NewInt(0)
 	totalDelegated := big.NewInt(0)
+	numSkippedAccounts := 0
+	addresses := make(map[string]struct{}, len(initialAccounts))
 	for _, ia := range initialAccounts {
-		if ia.StakingValue.Cmp(zero) < 0 {
-			return fmt.Errorf("%w for address %s, field StakingValue", ErrNegativeValue, ia.Address)
+		_, found := addresses[ia.Address]
+		if found {
+			report.addViolation(ErrDuplicatedAddress, Violation{
+				Address: ia.Address,
+				Field:   "Address",
+			})
 		}
-		if ia.Balance.Cmp(zero) < 0 {
-			return fmt.Errorf("%w for address %s, field Balance", ErrNegativeValue, ia.Address)
+		addresses[ia.Address] = struct{}{}
+
+		delegation := ia.Delegation
+		if delegation == nil {
+			delegation = &data.DelegationData{}
 		}
-		if ia.Supply.Cmp(zero) < 0 {
-			return fmt.Errorf("%w for address %s, field Supply", ErrNegativeValue, ia.Address)
+		values := []struct {
+			field string
+			value *big.Int
+		}{
+			{field: "StakingValue", value: ia.StakingValue},
+			{field: "Balance", value: ia.Balance},
+			{field: "Supply", value: ia.Supply},
+			{field: "Delegation.Value", value: delegation.Value},
 		}
-		if ia.Delegation.Value.Cmp(zero) < 0 {
-			return fmt.Errorf("%w for address %s, field Delegation.Value", ErrNegativeValue, ia.Address)
+		isValid := true
+		for _, element := range values {
+			if element.value == nil {
+				report.addViolation(ErrNilValue, Violation{
+					Address: ia.Address,
+					Field:   element.field,
+				})
+				isValid = false
+				continue
+			}
+			if element.value.Cmp(zero) < 0 {
+				report.addViolation(ErrNegativeValue, Violation{
+					Address:  ia.Address,
+					Field:    element.field,
+					Expected: ">= 0",
+					Actual:   element.value.String(),
+				})
+				isValid = false
+			}
+		}
+		if !isValid {
+			numSkippedAccounts++
+			continue
 		}
 
 		supply := big.NewInt(0)
 		supply.Add(supply, ia.Balance)
 		supply.Add(supply, ia.StakingValue)
-		supply.Add(supply, ia.Delegation.Value)
+		supply.Add(supply, delegation.Value)
 		if supply.Cmp(ia.Supply) != 0 {
-			return fmt.Errorf("%w for address %s", ErrSupplyMismatch, ia.Address)
+			report.addViolation(ErrSupplyMismatch, Violation{
+				Address:  ia.Address,
+				Field:    "Supply",
+				Expected: supply.String(),
+				Actual:   ia.Supply.String(),
+			})
 		}
 
-		remainder := big.NewInt(0).Set(ia.StakingValue)
-		remainder.Mod(remainder, iac.nodePrice)
-		if remainder.Cmp(zero) != 0 {
-			return ErrStakingValueError
+		// a staking account should cover at least the price of a node, the value above the nodes price being top-up
+		if ia.StakingValue.Cmp(zero) > 0 && ia.StakingValue.Cmp(iac.nodePrice) < 0 {
+			report.addViolation(ErrStakingValueError, Violation{
+				Address:  ia.Address,
+				Field:    "StakingValue",
+				Expected: ">= " + iac.nodePrice.String(),
+				Actual:   ia.StakingValue.String(),
+			})
 		}
 
-		if ia.Delegation.Value.Cmp(zero) > 0 {
-			if len(ia.Delegation.Address) == 0 {
-				return fmt.Errorf("%w for address %s", ErrDelegationValues, ia.Address)
-			}
+		if delegation.Value.Cmp(zero) > 0 && len(delegation.Address) == 0 {
+			report.addViolation(ErrDelegationValues, Violation{
+				Address:  ia.Address,
+				Field:    "Delegation.Address",
+				Expected: "not empty",
+			})
 		}
 
 		totalSupply.Add(totalSupply, supply)
 		totalBalance.Add(totalBalance, ia.Balance)
 		totalStaked.Add(totalStaked, ia.StakingValue)
-		totalDelegated.Add(totalDelegated, ia.Delegation.Value)
+		totalDelegated.Add(totalDelegated, delegation.Value)
 	}
 
-	if totalSupply.Cmp(iac.totalSupply) != 0 {
-		return fmt.Errorf("%w computed: %s, provided: %d", ErrTotalSupplyMismatch, totalSupply, iac.totalSupply)
+	// the total supply is meaningful only if the values of all the accounts were summed
+	if numSkippedAccounts == 0 && totalSupply.Cmp(iac.totalSupply) != 0 {
+		report.addViolation(ErrTotalSupplyMismatch, Violation{
+			Field:    "Supply",
+			Expected: iac.totalSupply.String(),
+			Actual:   totalSupply.String(),
+		})
+	}
+	if !report.IsValid() {
+		return report
 	}
 
 	log.Info("checked values",
-		"total supply", totalSupply.String(),
-		"total staked", totalStaked.String(),
-		"total balance", totalBalance.String(),
-		"total delegated", totalDelegated.String(),
+		"total supply", core.FormatAmount(totalSupply, iac.denomination),
+		"total staked", core.FormatAmount(totalStaked, iac.denomination),
+		"total balance", core.FormatAmount(totalBalance, iac.denomination),
+		"total delegated", core.FormatAmount(totalDelegated, iac.denomination),
 	)
 
-	return nil
+	return report
 }
@@ -6,14 +6,16 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-deploy-go/core"
 	"github.com/multiversx/mx-chain-go/genesis/data"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitialAccountsChecker_CheckInitialAccountsSupplyMismatch(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(2500), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(2500), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -35,7 +37,7 @@ func TestInitialAccountsChecker_CheckInitialAccountsSupplyMismatch(t *testing.T)
 func TestInitialAccountsChecker_CheckInitialAccountsTotalSupplyMismatch(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -54,10 +56,10 @@ func TestInitialAccountsChecker_CheckInitialAccountsTotalSupplyMismatch(t *testi
 	assert.True(t, errors.Is(err, ErrTotalSupplyMismatch))
 }
 
-func TestInitialAccountsChecker_CheckInitialAccountsStakingValueNotAMultiple(t *testing.T) {
+func TestInitialAccountsChecker_CheckInitialAccountsStakingValueBelowNodePrice(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(2), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(5), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -76,10 +78,32 @@ func TestInitialAccountsChecker_CheckInitialAccountsStakingValueNotAMultiple(t *
 	assert.True(t, errors.Is(err, ErrStakingValueError))
 }
 
+func TestInitialAccountsChecker_CheckInitialAccountsStakingValueWithTopUpShouldWork(t *testing.T) {
+	t.Parallel()
+
+	iac, _ := NewInitialAccountsChecker(big.NewInt(2), big.NewInt(20000000), core.DefaultDenomination)
+
+	initialAccounts := []data.InitialAccount{
+		{
+			Address:      "a",
+			Supply:       big.NewInt(20000000),
+			Balance:      big.NewInt(7),
+			StakingValue: big.NewInt(3),
+			Delegation: &data.DelegationData{
+				Address: "b",
+				Value:   big.NewInt(19999990),
+			},
+		},
+	}
+
+	err := iac.CheckInitialAccounts(initialAccounts)
+	assert.Nil(t, err)
+}
+
 func TestInitialAccountsChecker_CheckInitialAccountsDelegationError(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -101,7 +125,7 @@ func TestInitialAccountsChecker_CheckInitialAccountsDelegationError(t *testing.T
 func TestInitialAccountsChecker_CheckInitialAccountsNegativeSupply(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -124,7 +148,7 @@ func TestInitialAccountsChecker_CheckInitialAccountsNegativeSupply(t *testing.T)
 func TestInitialAccountsChecker_CheckInitialAccountsNegativeBalance(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -147,7 +171,7 @@ func TestInitialAccountsChecker_CheckInitialAccountsNegativeBalance(t *testing.T
 func TestInitialAccountsChecker_CheckInitialAccountsNegativeStakingValue(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -170,7 +194,7 @@ func TestInitialAccountsChecker_CheckInitialAccountsNegativeStakingValue(t *test
 func TestInitialAccountsChecker_CheckInitialAccountsNegativeDelegationValue(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -190,10 +214,42 @@ func TestInitialAccountsChecker_CheckInitialAccountsNegativeDelegationValue(t *t
 	assert.True(t, strings.Contains(err.Error(), "Delegation.Value"))
 }
 
+func TestInitialAccountsChecker_CheckInitialAccountsDuplicatedAddress(t *testing.T) {
+	t.Parallel()
+
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
+
+	initialAccounts := []data.InitialAccount{
+		{
+			Address:      "a",
+			Supply:       big.NewInt(10000000),
+			Balance:      big.NewInt(10000000),
+			StakingValue: big.NewInt(0),
+			Delegation: &data.DelegationData{
+				Address: "",
+				Value:   big.NewInt(0),
+			},
+		},
+		{
+			Address:      "a",
+			Supply:       big.NewInt(10000000),
+			Balance:      big.NewInt(10000000),
+			StakingValue: big.NewInt(0),
+			Delegation: &data.DelegationData{
+				Address: "",
+				Value:   big.NewInt(0),
+			},
+		},
+	}
+
+	err := iac.CheckInitialAccounts(initialAccounts)
+	assert.True(t, errors.Is(err, ErrDuplicatedAddress))
+}
+
 func TestInitialAccountsChecker_CheckInitialAccountsShouldWork(t *testing.T) {
 	t.Parallel()
 
-	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000))
+	iac, _ := NewInitialAccountsChecker(big.NewInt(1), big.NewInt(20000000), core.DefaultDenomination)
 
 	initialAccounts := []data.InitialAccount{
 		{
@@ -221,3 +277,60 @@ func TestInitialAccountsChecker_CheckInitialAccountsShouldWork(t *testing.T) {
 	err := iac.CheckInitialAccounts(initialAccounts)
 	assert.Nil(t, err)
 }
+
+func TestInitialAccountsChecker_ValidateInitialAccountsShouldCollectAllViolations(t *testing.T) {
+	t.Parallel()
+
+	iac, _ := NewInitialAccountsChecker(big.NewInt(5), big.NewInt(20000000), core.DefaultDenomination)
+
+	initialAccounts := []data.InitialAccount{
+		{
+			Address:      "a",
+			Supply:       big.NewInt(11),
+			Balance:      big.NewInt(7),
+			StakingValue: big.NewInt(3),
+			Delegation: &data.DelegationData{
+				Address: "",
+				Value:   big.NewInt(0),
+			},
+		},
+		{
+			Address:      "b",
+			Supply:       big.NewInt(10),
+			Balance:      big.NewInt(-10),
+			StakingValue: big.NewInt(-1),
+			Delegation: &data.DelegationData{
+				Address: "",
+				Value:   big.NewInt(0),
+			},
+		},
+		{
+			Address:      "a",
+			Supply:       big.NewInt(10),
+			Balance:      big.NewInt(0),
+			StakingValue: big.NewInt(0),
+			Delegation: &data.DelegationData{
+				Address: "",
+				Value:   big.NewInt(10),
+			},
+		},
+	}
+
+	report := iac.ValidateInitialAccounts(initialAccounts)
+	require.Equal(t, 6, len(report.Violations))
+	assert.True(t, errors.Is(report.Violations[0], ErrSupplyMismatch))
+	assert.Equal(t, "a", report.Violations[0].Address)
+	assert.Equal(t, "10", report.Violations[0].Expected)
+	assert.Equal(t, "11", report.Violations[0].Actual)
+	assert.True(t, errors.Is(report.Violations[1], ErrStakingValueError))
+	assert.Equal(t, "a", report.Violations[1].Address)
+	assert.Equal(t, "StakingValue", report.Violations[2].Field)
+	assert.Equal(t, "Balance", report.Violations[3].Field)
+	assert.Equal(t, "b", report.Violations[3].Address)
+	assert.True(t, errors.Is(report.Violations[4], ErrDuplicatedAddress))
+	assert.True(t, errors.Is(report.Violations[5], ErrDelegationValues))
+
+	err := iac.CheckInitialAccounts(initialAccounts)
+	assert.True(t, errors.Is(err, ErrStakingValueError))
+	assert.True(t, strings.Contains(err.Error(), "address: a, field: StakingValue"))
+}
@@ -0,0 +1,151 @@
+package check
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const unknownViolationCode = "CHK000"
+
+// violationCodes holds the code of each checked rule. The codes are meant to be parsed by CI pipelines, so an
+// existing code must never be changed or reused for another rule
+var violationCodes = map[error]string{
+	ErrNilValue:               "CHK001",
+	ErrEmptyInitialAccounts:   "CHK002",
+	ErrDuplicatedAddress:      "CHK003",
+	ErrNegativeValue:          "CHK004",
+	ErrSupplyMismatch:         "CHK005",
+	ErrStakingValueError:      "CHK006",
+	ErrDelegationValues:       "CHK007",
+	ErrTotalSupplyMismatch:    "CHK008",
+	ErrDuplicatedBlsKey:       "CHK009",
+	ErrUndecodableString:      "CHK010",
+	ErrInsufficientStake:      "CHK011",
+	ErrStakeWithoutNodes:      "CHK012",
+	ErrDelegationWithoutNodes: "CHK013",
+}
+
+// Violation describes a broken rule together with the account, the key and the field it was found on
+type Violation struct {
+	Code     string `json:"code"`
+	Rule     string `json:"rule"`
+	Address  string `json:"address,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	err      error
+}
+
+// Error returns the human readable form of the violation
+func (v *Violation) Error() string {
+	elements := []string{fmt.Sprintf("[%s] %s", v.Code, v.Rule)}
+	appendElement := func(name string, value string) {
+		if len(value) > 0 {
+			elements = append(elements, fmt.Sprintf("%s: %s", name, value))
+		}
+	}
+	appendElement("address", v.Address)
+	appendElement("key", v.Key)
+	appendElement("field", v.Field)
+	appendElement("expected", v.Expected)
+	appendElement("actual", v.Actual)
+
+	return strings.Join(elements, ", ")
+}
+
+// Unwrap returns the error describing the broken rule
+func (v *Violation) Unwrap() error {
+	return v.err
+}
+
+// Report collects all the violations found while checking
+type Report struct {
+	Violations []*Violation `json:"violations"`
+}
+
+// NewReport creates a new empty report
+func NewReport() *Report {
+	return &Report{
+		Violations: make([]*Violation, 0),
+	}
+}
+
+func (r *Report) addViolation(rule error, violation Violation) {
+	violation.err = rule
+	violation.Rule = rule.Error()
+	violation.Code = violationCodes[rule]
+	if len(violation.Code) == 0 {
+		violation.Code = unknownViolationCode
+	}
+
+	r.Violations = append(r.Violations, &violation)
+}
+
+// Merge appends the violations of the provided report
+func (r *Report) Merge(other *Report) {
+	if other == nil {
+		return
+	}
+
+	r.Violations = append(r.Violations, other.Violations...)
+}
+
+// IsValid returns true if no violation was found
+func (r *Report) IsValid() bool {
+	return len(r.Violations) == 0
+}
+
+// Err returns nil if no violation was found, otherwise a *ReportError holding this report
+func (r *Report) Err() error {
+	if r.IsValid() {
+		return nil
+	}
+
+	return &ReportError{
+		Report: r,
+	}
+}
+
+// String returns the human readable form of the report, one violation per line
+func (r *Report) String() string {
+	builder := &strings.Builder{}
+	_, _ = fmt.Fprintf(builder, "%d violations found\n", len(r.Violations))
+	for _, violation := range r.Violations {
+		_, _ = fmt.Fprintf(builder, "  %s\n", violation.Error())
+	}
+
+	return builder.String()
+}
+
+// ToJSON returns the JSON form of the report
+func (r *Report) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// ReportError is the error returned when at least one violation was found. Each violation can be matched, through
+// errors.Is, against the error describing its rule
+type ReportError struct {
+	Report *Report
+}
+
+// Error returns all the violations on a single line
+func (re *ReportError) Error() string {
+	violations := make([]string, 0, len(re.Report.Violations))
+	for _, violation := range re.Report.Violations {
+		violations = append(violations, violation.Error())
+	}
+
+	return fmt.Sprintf("%d violations found: %s", len(violations), strings.Join(violations, "; "))
+}
+
+// Unwrap returns the violations
+func (re *ReportError) Unwrap() []error {
+	errs := make([]error, 0, len(re.Report.Violations))
+	for _, violation := range re.Report.Violations {
+		errs = append(errs, violation)
+	}
+
+	return errs
+}
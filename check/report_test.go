@@ -0,0 +1,111 @@
+package check
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport_ViolationCodesShouldBeUnique(t *testing.T) {
+	t.Parallel()
+
+	codes := make(map[string]error)
+	for rule, code := range violationCodes {
+		existingRule, found := codes[code]
+		assert.False(t, found, "code %s is used by both %v and %v", code, rule, existingRule)
+		codes[code] = rule
+	}
+}
+
+func TestReport_EmptyReportShouldBeValid(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport()
+	assert.True(t, report.IsValid())
+	assert.Nil(t, report.Err())
+}
+
+func TestReport_AddViolation(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport()
+	report.addViolation(ErrSupplyMismatch, Violation{
+		Address:  "a",
+		Field:    "Supply",
+		Expected: "10",
+		Actual:   "9",
+	})
+	report.addViolation(errors.New("unlisted rule"), Violation{})
+
+	require.Equal(t, 2, len(report.Violations))
+	assert.Equal(t, "CHK005", report.Violations[0].Code)
+	assert.Equal(t, ErrSupplyMismatch.Error(), report.Violations[0].Rule)
+	assert.Equal(t, "[CHK005] supply mismatch, address: a, field: Supply, expected: 10, actual: 9",
+		report.Violations[0].Error())
+	assert.Equal(t, unknownViolationCode, report.Violations[1].Code)
+	assert.False(t, report.IsValid())
+}
+
+func TestReport_ErrShouldUnwrapAllViolations(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport()
+	report.addViolation(ErrSupplyMismatch, Violation{Address: "a"})
+	report.addViolation(ErrDuplicatedAddress, Violation{Address: "b"})
+
+	err := report.Err()
+	assert.True(t, errors.Is(err, ErrSupplyMismatch))
+	assert.True(t, errors.Is(err, ErrDuplicatedAddress))
+	assert.False(t, errors.Is(err, ErrNegativeValue))
+	assert.True(t, strings.HasPrefix(err.Error(), "2 violations found: "))
+
+	reportError := &ReportError{}
+	require.True(t, errors.As(err, &reportError))
+	assert.True(t, report == reportError.Report)
+}
+
+func TestReport_Merge(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport()
+	report.addViolation(ErrSupplyMismatch, Violation{Address: "a"})
+	other := NewReport()
+	other.addViolation(ErrDuplicatedAddress, Violation{Address: "b"})
+
+	report.Merge(other)
+	report.Merge(nil)
+	require.Equal(t, 2, len(report.Violations))
+	assert.Equal(t, "b", report.Violations[1].Address)
+}
+
+func TestReport_TextAndJsonForms(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport()
+	report.addViolation(ErrStakingValueError, Violation{
+		Address:  "a",
+		Field:    "StakingValue",
+		Expected: ">= 5",
+		Actual:   "3",
+	})
+
+	assert.Equal(t, "1 violations found\n  [CHK006] staking value error, address: a, field: StakingValue, "+
+		"expected: >= 5, actual: 3\n", report.String())
+
+	buff, err := report.ToJSON()
+	require.Nil(t, err)
+	decoded := &Report{}
+	require.Nil(t, json.Unmarshal(buff, decoded))
+	require.Equal(t, 1, len(decoded.Violations))
+	assert.Equal(t, "CHK006", decoded.Violations[0].Code)
+	assert.Equal(t, "staking value error", decoded.Violations[0].Rule)
+	assert.Equal(t, "a", decoded.Violations[0].Address)
+	assert.Equal(t, "StakingValue", decoded.Violations[0].Field)
+	assert.Equal(t, ">= 5", decoded.Violations[0].Expected)
+	assert.Equal(t, "3", decoded.Violations[0].Actual)
+	assert.False(t, strings.Contains(string(buff), "\"key\""))
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	edSingleSig "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	blsSingleSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-deploy-go/ceremony"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/filegen"
+	"github.com/urfave/cli"
+)
+
+const submissionFileExtension = ".json"
+
+var (
+	submitWalletKey = cli.StringFlag{
+		Name:  "wallet-key",
+		Usage: "path to the PEM file containing the owner's wallet key. The first key in the file will be used",
+		Value: "./walletKey.pem",
+	}
+	submitValidatorKeys = cli.StringFlag{
+		Name:  "validator-keys",
+		Usage: "path to the PEM file containing the BLS keys of the validators to be submitted",
+		Value: "./validatorKey.pem",
+	}
+	submitStake = cli.StringFlag{
+		Name:  "stake",
+		Usage: "the requested stake. If not set, it will be computed as number of validators * node price",
+	}
+	submitOutputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "path of the resulting submission file",
+		Value: "./submission.json",
+	}
+	assembleSubmissionsDirectory = cli.StringFlag{
+		Name:  "submissions-directory",
+		Usage: "directory containing the submission files (*.json). The files are processed in alphabetical order",
+		Value: "./submissions",
+	}
+	assembleRemainderAddress = cli.StringFlag{
+		Name: "remainder-address",
+		Usage: "bech32 address that will receive the part of the total supply not used for staking and " +
+			"owners' balances. Can be one of the owners",
+	}
+	assembleOwnerBalance = cli.StringFlag{
+		Name:  "owner-balance",
+		Usage: "balance credited to each owner, besides its stake",
+		Value: "1000000000000000000",
+	}
+
+	submitCommand = cli.Command{
+		Name: "submit",
+		Usage: "creates a signed submission containing the owner address, the BLS public keys with their " +
+			"proof-of-possession and the requested stake. No secret key is written in the submission",
+		Flags: []cli.Flag{
+			submitWalletKey,
+			submitValidatorKeys,
+			submitStake,
+			submitOutputFile,
+		},
+		Action: submit,
+	}
+	assembleCommand = cli.Command{
+		Name: "assemble",
+		Usage: "verifies all the submissions and builds the genesis.json and nodesSetup.json files out of them, " +
+			"using the global options for the network and economics parameters",
+		Flags: []cli.Flag{
+			assembleSubmissionsDirectory,
+			assembleRemainderAddress,
+			assembleOwnerBalance,
+		},
+		Action: assemble,
+	}
+
+	errMissingValidatorSecretKey    = errors.New("missing validator secret key")
+	errNotEnoughSubmittedValidators = errors.New("not enough submitted validators")
+)
+
+func createSubmissionHandler() (ceremony.SubmissionHandler, error) {
+	validatorPubKeyConverter, walletPubKeyConverter, err := filegen.CreatePubKeyConverters()
+	if err != nil {
+		return nil, err
+	}
+
+	return ceremony.NewSubmissionHandler(ceremony.ArgSubmissionHandler{
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		WalletSigner:             &edSingleSig.Ed25519Signer{},
+		ValidatorSigner:          blsSingleSig.NewBlsSigner(),
+		WalletPubKeyConverter:    walletPubKeyConverter,
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+	})
+}
+
+func submit(ctx *cli.Context) error {
+	scenario, err := loadScenarioConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	ownerSkHex, ownerAddress, err := mxCore.LoadSkPkFromPemFile(ctx.String(submitWalletKey.Name), 0)
+	if err != nil {
+		return fmt.Errorf("%w while loading the wallet key", err)
+	}
+	ownerSkBytes, err := hex.DecodeString(string(ownerSkHex))
+	if err != nil {
+		return fmt.Errorf("%w for the wallet key of %s", err, ownerAddress)
+	}
+
+	validatorPubKeyConverter, _, err := filegen.CreatePubKeyConverters()
+	if err != nil {
+		return err
+	}
+	validatorKeys, err := core.LoadValidatorKeys(
+		ctx.String(submitValidatorKeys.Name),
+		signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		validatorPubKeyConverter,
+	)
+	if err != nil {
+		return fmt.Errorf("%w while loading the validator keys", err)
+	}
+
+	validatorsSkBytes := make([][]byte, 0, len(validatorKeys))
+	for i, key := range validatorKeys {
+		if len(key.PrivKeyBytes) == 0 {
+			return fmt.Errorf("%w at index %d", errMissingValidatorSecretKey, i)
+		}
+
+		validatorsSkBytes = append(validatorsSkBytes, key.PrivKeyBytes)
+	}
+
+	stake, err := computeSubmissionStake(ctx, scenario.Economics, len(validatorKeys))
+	if err != nil {
+		return err
+	}
+
+	submissionHandler, err := createSubmissionHandler()
+	if err != nil {
+		return err
+	}
+
+	submission, err := submissionHandler.CreateSubmission(ownerSkBytes, validatorsSkBytes, stake)
+	if err != nil {
+		return err
+	}
+
+	outputDirectory, outputFileName := filepath.Split(ctx.String(submitOutputFile.Name))
+	fileHandler, err := core.NewFileHandler(outputDirectory, outputFileName)
+	if err != nil {
+		return err
+	}
+	defer fileHandler.Close()
+
+	err = fileHandler.WriteObjectInFile(submission)
+	if err != nil {
+		return err
+	}
+
+	log.Info("submission created",
+		"owner", submission.OwnerAddress,
+		"num validators", len(submission.Validators),
+		"stake", core.FormatAmount(stake, scenario.Economics.Denomination),
+		"file", ctx.String(submitOutputFile.Name),
+	)
+
+	return nil
+}
+
+func computeSubmissionStake(
+	ctx *cli.Context,
+	economicsConfig config.EconomicsConfig,
+	numValidators int,
+) (*big.Int, error) {
+	if ctx.IsSet(submitStake.Name) {
+		return core.ConvertAmount(ctx.String(submitStake.Name), economicsConfig.Denomination)
+	}
+
+	nodePriceValue, err := core.ConvertAmount(economicsConfig.NodePrice, economicsConfig.Denomination)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the node price", err)
+	}
+
+	return big.NewInt(0).Mul(nodePriceValue, big.NewInt(int64(numValidators))), nil
+}
+
+func assemble(ctx *cli.Context) error {
+	scenario, err := loadScenarioConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	submissions, err := loadSubmissions(ctx.String(assembleSubmissionsDirectory.Name))
+	if err != nil {
+		return err
+	}
+
+	denominationValue := scenario.Economics.Denomination
+	totalSupplyValue, err := core.ConvertAmount(scenario.Economics.TotalSupply, denominationValue)
+	if err != nil {
+		return fmt.Errorf("%w for the total supply", err)
+	}
+	nodePriceValue, err := core.ConvertAmount(scenario.Economics.NodePrice, denominationValue)
+	if err != nil {
+		return fmt.Errorf("%w for the node price", err)
+	}
+	ownerBalanceValue, err := core.ConvertAmount(ctx.String(assembleOwnerBalance.Name), denominationValue)
+	if err != nil {
+		return fmt.Errorf("%w for the owner balance", err)
+	}
+
+	validatorPubKeyConverter, walletPubKeyConverter, err := filegen.CreatePubKeyConverters()
+	if err != nil {
+		return err
+	}
+
+	allocations, assembledSupplyValue, err := filegen.LoadAllocations(
+		scenario.Economics.AllocationsFile,
+		totalSupplyValue,
+		denominationValue,
+		walletPubKeyConverter,
+	)
+	if err != nil {
+		return err
+	}
+
+	snapshot, assembledSupplyValue, err := filegen.LoadSnapshot(
+		scenario.Economics,
+		assembledSupplyValue,
+		nodePriceValue,
+		walletPubKeyConverter,
+	)
+	if err != nil {
+		return err
+	}
+
+	submissionHandler, err := createSubmissionHandler()
+	if err != nil {
+		return err
+	}
+
+	genesisAssembler, err := ceremony.NewAssembler(ceremony.ArgAssembler{
+		SubmissionVerifier:       submissionHandler,
+		WalletPubKeyConverter:    walletPubKeyConverter,
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+		NodePrice:                nodePriceValue,
+		TotalSupply:              assembledSupplyValue,
+		OwnerBalance:             ownerBalanceValue,
+		RemainderAddress:         ctx.String(assembleRemainderAddress.Name),
+		InitialRating:            uint32(scenario.Network.InitialRating),
+	})
+	if err != nil {
+		return err
+	}
+
+	generatedOutput, err := genesisAssembler.Assemble(submissions)
+	if err != nil {
+		return err
+	}
+	generatedOutput.InitialAccounts = append(generatedOutput.InitialAccounts, allocations...)
+	generatedOutput.InitialAccounts = append(generatedOutput.InitialAccounts, snapshot...)
+
+	minNumValidators := scenario.Network.NumOfShards*scenario.Network.NumOfNodesInEachShard +
+		scenario.Network.NumOfMetachainNodes
+	if len(generatedOutput.ValidatorBlsKeys) < minNumValidators {
+		return fmt.Errorf("%w, submitted: %d, minimum: %d",
+			errNotEnoughSubmittedValidators, len(generatedOutput.ValidatorBlsKeys), minNumValidators)
+	}
+
+	err = filegen.CheckGeneratedOutput(
+		generatedOutput,
+		nodePriceValue,
+		totalSupplyValue,
+		denominationValue,
+		walletPubKeyConverter,
+		validatorPubKeyConverter,
+	)
+	if err != nil {
+		return err
+	}
+
+	// no secret key is known, so only the public files are relevant
+	scenario.Output.GenerateTxgenFile = false
+	scenario.Output.WalletKeyFormat = core.PemWalletKeyFormat
+	scenario.Output.Layout = core.ClassicOutputLayout
+	scenario.Network.NumOfObserversInEachShard = 0
+	scenario.Network.NumOfObserversInMetachain = 0
+	err = filegen.Write(scenario, generatedOutput)
+	if err != nil {
+		return err
+	}
+
+	log.Info("genesis assembled successfully!", "num submissions", len(submissions))
+	return nil
+}
+
+func loadSubmissions(directory string) ([]*ceremony.Submission, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	fileNames := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != submissionFileExtension {
+			continue
+		}
+
+		fileNames = append(fileNames, entry.Name())
+	}
+	sort.Strings(fileNames)
+
+	submissions := make([]*ceremony.Submission, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		submission := &ceremony.Submission{}
+		err = mxCore.LoadJsonFile(submission, filepath.Join(directory, fileName))
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading submission %s", err, fileName)
+		}
+
+		log.Debug("loaded submission", "file", fileName, "owner", submission.OwnerAddress)
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, nil
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	"github.com/multiversx/mx-chain-deploy-go/filegen"
+	generation "github.com/multiversx/mx-chain-deploy-go/generate"
+	"github.com/multiversx/mx-chain-deploy-go/generate/deterministic"
+	"github.com/multiversx/mx-chain-deploy-go/plugins"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/urfave/cli"
+)
+
+var (
+	extendDirectory = cli.StringFlag{
+		Name:  "dir",
+		Usage: "the output directory to be extended. If not set, the output directory of the scenario will be used",
+	}
+
+	extendCommand = cli.Command{
+		Name: "extend",
+		Usage: "adds validators and observers to an existing output directory, up to the network size given by the " +
+			"global options. The new validators are staked directly by new owners, the balance left after staking " +
+			"is distributed again between the owners and the files are rewritten, preserving every existing key " +
+			"and address",
+		Flags: []cli.Flag{
+			extendDirectory,
+		},
+		Action: extend,
+	}
+)
+
+type extendedOutputLoader interface {
+	LoadGeneratorOutput(keystorePassphrase string) (*data.GeneratorOutput, error)
+	LoadGenesisSmartContracts() ([]*mxData.InitialSmartContract, error)
+	ValidatorKeysFilePaths() ([]string, error)
+}
+
+func extend(ctx *cli.Context) error {
+	startTime := time.Now()
+	scenario, err := loadScenarioConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if ctx.IsSet(extendDirectory.Name) {
+		scenario.Output.Directory = ctx.String(extendDirectory.Name)
+	}
+
+	err = filegen.CheckKeystorePassphrase(scenario.Output)
+	if err != nil {
+		return err
+	}
+	// the passphrase is also needed for decrypting the wallet mnemonic of a network written with keystores, the
+	// loader reporting it as missing only if the existing mnemonic is encrypted
+	keystorePassphrase, _ := filegen.ReadKeystorePassphrase(scenario.Output)
+
+	outputDirectory := scenario.Output.Directory
+	denominationValue := scenario.Economics.Denomination
+	numValidators, numObservers, err := filegen.ComputeNumNodes(scenario.Network)
+	if err != nil {
+		return err
+	}
+
+	totalSupplyValue, err := core.ConvertAmount(scenario.Economics.TotalSupply, denominationValue)
+	if err != nil {
+		return fmt.Errorf("%w for the total supply", err)
+	}
+	nodePriceValue, err := core.ConvertAmount(scenario.Economics.NodePrice, denominationValue)
+	if err != nil {
+		return fmt.Errorf("%w for the node price", err)
+	}
+
+	validatorPubKeyConverter, walletPubKeyConverter, err := filegen.CreatePubKeyConverters()
+	if err != nil {
+		return err
+	}
+
+	loader, err := plugins.NewOutputLoader(plugins.ArgOutputLoader{
+		OutputDirectory:          outputDirectory,
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+		WalletPubKeyConverter:    walletPubKeyConverter,
+		NumOfShards:              uint32(scenario.Network.NumOfShards),
+	})
+	if err != nil {
+		return err
+	}
+
+	existingOutput, err := loader.LoadGeneratorOutput(keystorePassphrase)
+	if err != nil {
+		return err
+	}
+	log.Info("existing network",
+		"directory", outputDirectory,
+		"validators", len(existingOutput.InitialNodes),
+		"observers", len(existingOutput.ObserverBlsKeys),
+		"accounts", len(existingOutput.InitialAccounts),
+	)
+
+	err = filegen.CheckGeneratedOutput(
+		existingOutput,
+		nodePriceValue,
+		totalSupplyValue,
+		denominationValue,
+		walletPubKeyConverter,
+		validatorPubKeyConverter,
+	)
+	if err != nil {
+		return fmt.Errorf("%w while checking the existing network", err)
+	}
+
+	keyGens, err := createExtendKeyGenerators(scenario.Keys, existingOutput)
+	if err != nil {
+		return err
+	}
+
+	balancePolicyHandler, err := filegen.CreateBalancePolicy(scenario.Economics, totalSupplyValue, walletPubKeyConverter)
+	if err != nil {
+		return err
+	}
+
+	policies, err := filegen.CreateStakingPolicies(scenario, keyGens.IntRandomizer, walletPubKeyConverter)
+	if err != nil {
+		return err
+	}
+
+	extender, err := generation.NewNetworkExtender(generation.ArgNetworkExtender{
+		KeyGeneratorForValidators: keyGens.ValidatorKeyGenerator,
+		KeyGeneratorForWallets:    keyGens.WalletKeyGenerator,
+		WalletPubKeyConverter:     walletPubKeyConverter,
+		ValidatorPubKeyConverter:  validatorPubKeyConverter,
+		NumValidatorBlsKeys:       uint(numValidators),
+		NumObserverBlsKeys:        uint(numObservers),
+		BalancePolicy:             balancePolicyHandler,
+		OwnerDistribution:         policies.OwnerDistribution,
+		TopUpPolicy:               policies.TopUpPolicy,
+		NodePrice:                 nodePriceValue,
+		TotalSupply:               totalSupplyValue,
+		RatingProfile:             policies.RatingProfile,
+	})
+	if err != nil {
+		return err
+	}
+
+	extendedOutput, err := extender.Extend(existingOutput)
+	if err != nil {
+		return err
+	}
+
+	if keyGens.MnemonicKeyGenerator != nil {
+		filegen.SetDerivationIndexes(extendedOutput, keyGens.MnemonicKeyGenerator)
+	}
+
+	err = filegen.CheckGeneratedOutput(
+		extendedOutput,
+		nodePriceValue,
+		totalSupplyValue,
+		denominationValue,
+		walletPubKeyConverter,
+		validatorPubKeyConverter,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = prepareExtendedOutput(scenario, loader)
+	if err != nil {
+		return err
+	}
+
+	err = filegen.Write(scenario, extendedOutput)
+	if err != nil {
+		return err
+	}
+
+	log.Info("network extended",
+		"validators", len(extendedOutput.InitialNodes),
+		"observers", len(extendedOutput.ObserverBlsKeys),
+		"accounts", len(extendedOutput.InitialAccounts),
+	)
+	log.Info("elapsed time", "value", time.Since(startTime))
+	log.Info("files extended successfully!")
+	return nil
+}
+
+// createExtendKeyGenerators creates the key generators of the new keys. The seed, if any, is personalized with the size
+// of the existing network, so the new keys differ from the ones generated out of the same seed before. The wallet keys
+// of a network generated out of a mnemonic are derived from the same mnemonic, after the last used derivation index
+func createExtendKeyGenerators(
+	keysConfig config.KeysConfig,
+	existingOutput *data.GeneratorOutput,
+) (*filegen.KeyGenerators, error) {
+	keysConfig.UseMnemonic = false
+	keysConfig.MnemonicFile = ""
+	if len(keysConfig.Seed) > 0 {
+		keysConfig.Seed = fmt.Sprintf("%s/extend/%d/%d", keysConfig.Seed, len(existingOutput.InitialNodes),
+			len(existingOutput.ObserverBlsKeys))
+	}
+
+	keyGens, err := filegen.CreateKeyGenerators(keysConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(existingOutput.Mnemonic) == 0 {
+		return keyGens, nil
+	}
+
+	walletKeyGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
+	mkg, err := deterministic.NewMnemonicKeyGenerator(walletKeyGenerator, existingOutput.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	numDerivedKeys := computeNumDerivedKeys(existingOutput)
+	for i := uint32(0); i < numDerivedKeys; i++ {
+		_, _ = mkg.GeneratePair()
+	}
+
+	log.Info("wallet keys will be derived from the existing mnemonic", "first derivation index", numDerivedKeys)
+	keyGens.WalletKeyGenerator = mkg
+	keyGens.MnemonicKeyGenerator = mkg
+
+	return keyGens, nil
+}
+
+func computeNumDerivedKeys(existingOutput *data.GeneratorOutput) uint32 {
+	allWalletKeys := make([]*data.WalletKey, 0)
+	allWalletKeys = append(allWalletKeys, existingOutput.WalletKeys...)
+	allWalletKeys = append(allWalletKeys, existingOutput.DelegatorKeys...)
+	allWalletKeys = append(allWalletKeys, existingOutput.AdditionalKeys...)
+
+	numDerivedKeys := uint32(0)
+	for _, key := range allWalletKeys {
+		if key.DerivationIndex+1 > numDerivedKeys {
+			numDerivedKeys = key.DerivationIndex + 1
+		}
+	}
+
+	return numDerivedKeys
+}
+
+// prepareExtendedOutput adjusts the scenario so that the rewritten files keep the existing values and removes the
+// validator keys files, as they are written again, possibly in other node directories
+func prepareExtendedOutput(scenario *config.ScenarioConfig, loader extendedOutputLoader) error {
+	genesisContracts, err := loader.LoadGenesisSmartContracts()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if len(genesisContracts) > 0 {
+		scenario.Staking.DelegationInit = genesisContracts[0].InitParameters
+		scenario.Staking.DelegationVersion = genesisContracts[0].Version
+	}
+
+	if scenario.Output.GenerateTxgenFile {
+		log.Warn("the txgen accounts file is not written when extending a network, the existing file is kept")
+		scenario.Output.GenerateTxgenFile = false
+	}
+
+	filePaths, err := loader.ValidatorKeysFilePaths()
+	if err != nil {
+		return err
+	}
+	for _, filePath := range filePaths {
+		err = os.Remove(filePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,45 +1,33 @@
 package main
 
 import (
-	"errors"
+	"context"
 	"fmt"
-	"math"
 	"os"
+	"strings"
 	"time"
 
-	mxCore "github.com/multiversx/mx-chain-core-go/core"
-	"github.com/multiversx/mx-chain-core-go/core/random"
-	crypto "github.com/multiversx/mx-chain-crypto-go"
-	"github.com/multiversx/mx-chain-crypto-go/signing"
-	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
-	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
-	mxCommonFactory "github.com/multiversx/mx-chain-go/common/factory"
-	"github.com/multiversx/mx-chain-go/config"
-	"github.com/multiversx/mx-chain-go/sharding"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 
-	"github.com/multiversx/mx-chain-deploy-go/check"
 	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/filegen"
 	"github.com/multiversx/mx-chain-deploy-go/generate/factory"
 	"github.com/multiversx/mx-chain-deploy-go/plugins"
 )
 
-const walletPubKeyFormat = "bech32"
-const validatorPubKeyFormat = "hex"
-const vmType = "0500"
-const delegationOwnerNonce = uint64(0)
-const egldHrp = "erd"
-
 var (
 	fileGenHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
 USAGE:
-   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} [command [command options]]{{end}}
    {{if len .Authors}}
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -53,13 +41,15 @@ VERSION:
 		Value: "./output",
 	}
 	totalSupply = cli.StringFlag{
-		Name:  "total-supply",
-		Usage: "Total supply available",
+		Name: "total-supply",
+		Usage: "Total supply available. All the amounts are raw integers in the smallest denomination or denominated " +
+			"amounts as in '20M EGLD', '2500EGLD' or '2.5e21', underscores being allowed as digit separators",
 		Value: "20000000000000000000000000",
 	}
 	nodePrice = cli.StringFlag{
-		Name:  "node-price",
-		Usage: "The cost for a node",
+		Name: "node-price",
+		Usage: "The price of a node, as a raw integer in the smallest denomination or a denominated amount as in " +
+			"'2500 EGLD'",
 		Value: "2500000000000000000000",
 	}
 	numOfShards = cli.IntFlag{
@@ -107,6 +97,23 @@ VERSION:
 		Usage: "The initial rating to be used for each node",
 		Value: 5000001,
 	}
+	ratingProfile = cli.StringFlag{
+		Name: "rating-profile",
+		Usage: "defines the initial rating of each node: 'fixed[:rating]' (rating defaults to initial-rating) or " +
+			"'random:min,max' (random rating in [min, max])",
+		Value: "fixed",
+	}
+	ratingOverrides = cli.StringFlag{
+		Name: "rating-overrides",
+		Usage: "comma separated list of address:rating pairs overriding the initial rating of the nodes held by the " +
+			"provided addresses (the owner for the directly staked nodes, the delegation contract for the delegated ones)",
+		Value: "",
+	}
+	denomination = cli.IntFlag{
+		Name:  "denomination",
+		Usage: "the number of decimals of an EGLD, used when converting the denominated amounts",
+		Value: core.DefaultDenomination,
+	}
 	hysteresis = cli.Float64Flag{
 		Name: "hysteresis",
 		Usage: "Hysteresis value - multiplied with numOfNodesPerShard to compute number of nodes allowed in the " +
@@ -123,8 +130,8 @@ VERSION:
 	}
 	stakeType = cli.StringFlag{
 		Name: "stake-type",
-		Usage: "defines the 2 possible ways to stake the nodes: 'direct' as in direct staking " +
-			"and 'delegated' that will stake the nodes through delegation",
+		Usage: "defines the way the nodes are staked, as <type>[:<parameters>]. The registered types are: " +
+			registeredStakeTypesUsage(),
 		Value: "direct",
 	}
 	delegationOwnerPublicKey = cli.StringFlag{
@@ -137,6 +144,45 @@ VERSION:
 		Usage: "number of delegators if the stake-type is of type `delegated` or `mixed`",
 		Value: 100,
 	}
+	delegatorDistribution = cli.StringFlag{
+		Name: "delegator-distribution",
+		Usage: "defines the value delegated by each delegator if the stake-type is of type `delegated` or `mixed`: " +
+			"'equal', 'random:max' (random value in [min-delegation, max]), 'pareto[:alpha]' (Pareto distribution " +
+			"on top of the min-delegation, alpha defaults to 1.16) or 'list:path' (file containing one value per " +
+			"line, for each delegator). The delegated values of each delegation provider always sum up to its " +
+			"number of nodes * node price. With several providers, the list is split in order: the first provider " +
+			"takes as many values as its delegators, the next provider the following ones and so on, so the list " +
+			"length is the total number of delegators",
+		Value: "equal",
+	}
+	minDelegation = cli.StringFlag{
+		Name: "min-delegation",
+		Usage: "the minimum value delegated by each delegator, as a raw integer in the smallest denomination or a " +
+			"denominated amount as in '10 EGLD'",
+		Value: "0",
+	}
+	delegationServiceFee = cli.Uint64Flag{
+		Name:  "delegation-service-fee",
+		Usage: "the service fee of the genesis delegation contracts, in hundredths of a percent (1000 = 10%)",
+		Value: 1000,
+	}
+	delegationCap = cli.StringFlag{
+		Name: "delegation-cap",
+		Usage: "the delegation cap of the genesis delegation contracts, 0 meaning uncapped, as a raw integer in the " +
+			"smallest denomination or a denominated amount as in '100K EGLD'",
+		Value: "0",
+	}
+	delegationInit = cli.StringFlag{
+		Name: "delegation-init",
+		Usage: "custom init parameters string for the genesis delegation contracts. If not provided, it will be " +
+			"built out of the validator system SC address, the delegation-service-fee and the delegation-cap values",
+		Value: "",
+	}
+	delegationVersion = cli.StringFlag{
+		Name:  "delegation-version",
+		Usage: "the version of the genesis delegation contracts",
+		Value: "0.4.*",
+	}
 	numDelegatedNodes = cli.UintFlag{
 		Name:  "num-delegated-nodes",
 		Usage: "number of delegated nodes if the stake-type is of type `mixed`",
@@ -147,21 +193,143 @@ VERSION:
 		Usage: "maximum number of validators held by an owner. The value will vary between [1-max] randomly.",
 		Value: 1,
 	}
+	ownerDistribution = cli.StringFlag{
+		Name: "owner-distribution",
+		Usage: "defines how many validators each owner holds: 'fixed[:N]' (N defaults to max-num-validators-per-node), " +
+			"'uniform' (random in [1, max-num-validators-per-node]), 'zipf[:exponent]' (power-law in " +
+			"[1, max-num-validators-per-node], exponent defaults to 1.0) or 'list:n1,n2,...' (explicit owner sizes, " +
+			"the remaining nodes being held by single node owners)",
+		Value: "uniform",
+	}
+	topUpPolicy = cli.StringFlag{
+		Name: "top-up-policy",
+		Usage: "defines the value each owner stakes on top of its nodes price: 'none', 'fixed:value', " +
+			"'random:min,max' (random value in [min, max]) or 'proportional:percent' (percent of the owner's nodes price)",
+		Value: "none",
+	}
 	richestAccount = cli.BoolFlag{
 		Name: "richest-account",
-		Usage: "if this flag is set, all the remaining balance will be credited to a new account. " +
-			"This flag is useful in tests involving automated stake events. All other account will still" +
-			"receive 1eGLD in order to complete some transactions (unstake, for instance)",
+		Usage: "if this flag is set, all the balance left after the minimum balances will be credited to the " +
+			"remainder sink account. This flag is useful in tests involving automated stake events. All other " +
+			"accounts will still receive their minimum balance in order to complete some transactions (unstake, for instance)",
+	}
+	minOwnerBalance = cli.StringFlag{
+		Name: "min-owner-balance",
+		Usage: "the minimum balance of each owner account, as a raw integer in the smallest denomination or a " +
+			"denominated amount as in '1 EGLD'",
+		Value: "1000000000000000000",
+	}
+	minDelegatorBalance = cli.StringFlag{
+		Name: "min-delegator-balance",
+		Usage: "the balance of each delegator account, so it can claim its rewards, as a raw integer in the smallest " +
+			"denomination or a denominated amount as in '1 EGLD'",
+		Value: "1000000000000000000",
+	}
+	minAdditionalBalance = cli.StringFlag{
+		Name: "min-additional-balance",
+		Usage: "the minimum balance of each additional (txgen) account, as a raw integer in the smallest " +
+			"denomination or a denominated amount as in '1 EGLD'",
+		Value: "1000000000000000000",
+	}
+	remainderSink = cli.StringFlag{
+		Name: "remainder-sink",
+		Usage: "the account receiving the balance remainder and, in the richest-account mode, all the balance left " +
+			"after the minimum balances: 'owner' (the first owner), 'additional' (the first additional account) or " +
+			"'treasury' (the treasury-address account)",
+		Value: "owner",
+	}
+	treasuryAddress = cli.StringFlag{
+		Name:  "treasury-address",
+		Usage: "the bech32 address of an optional treasury account, receiving the treasury-percent of the total supply",
+		Value: "",
+	}
+	treasuryPercent = cli.Float64Flag{
+		Name:  "treasury-percent",
+		Usage: "the percent of the total supply reserved for the treasury-address account",
+		Value: 0,
+	}
+	allocationsFile = cli.StringFlag{
+		Name: "allocations",
+		Usage: "path to a JSON file containing fixed genesis allocations (address, balance and optional delegation). " +
+			"The allocations are reserved from the total supply first and merged in the genesis.json file together " +
+			"with the generated accounts. They do not own any node, so a non-zero stakingValue is rejected",
+	}
+	snapshotFile = cli.StringFlag{
+		Name: "snapshot",
+		Usage: "path to a CSV or JSON balances snapshot (address, balance, optional staked and optional delegated) " +
+			"imported in the genesis.json file, for a shadow-fork network. The staked and delegated amounts are " +
+			"added to the balances and the snapshot is reserved from the total supply after the allocations",
+	}
+	snapshotSupply = cli.StringFlag{
+		Name: "snapshot-supply",
+		Usage: "if set, the snapshot balances are scaled so that they sum up to this amount, either raw or " +
+			"denominated, e.g. 15M EGLD",
 	}
 	roundDuration = cli.UintFlag{
 		Name:  "round-duration",
 		Usage: "round duration in miliseconds",
 		Value: 6000,
 	}
-
-	errInvalidNumPrivPubKeys = errors.New("invalid number of private/public keys to generate")
-	errInvalidNumOfNodes     = errors.New("invalid number of nodes in shard/metachain or in the consensus group")
-	log                      = logger.GetOrCreate("main")
+	seed = cli.StringFlag{
+		Name: "seed",
+		Usage: "if set, all the keys, the random choices and the keystores salts will be derived from this seed so the " +
+			"same seed and scenario will always produce identical files. Should only be used for test networks",
+	}
+	walletMnemonic = cli.BoolFlag{
+		Name: "wallet-mnemonic",
+		Usage: "if set, all the wallet keys (owners, delegators and additional accounts) will be derived from a " +
+			"newly generated BIP39 mnemonic on the m/44'/508'/0'/0'/index' path. The index of each account and " +
+			"the mnemonic, encrypted with the keystore passphrase, will be saved in the walletMnemonic.json file. " +
+			"With the pem wallet key format, the mnemonic is only saved if wallet-mnemonic-plaintext is set",
+	}
+	walletMnemonicFile = cli.StringFlag{
+		Name: "wallet-mnemonic-file",
+		Usage: "path to a file containing the BIP39 mnemonic from which the wallet keys will be derived. " +
+			"Implies the wallet-mnemonic flag",
+	}
+	walletMnemonicPlaintext = cli.BoolFlag{
+		Name: "wallet-mnemonic-plaintext",
+		Usage: "if set together with the pem wallet key format, the wallet mnemonic will be saved as plaintext in " +
+			"the walletMnemonic.json file. Should only be used for local test networks",
+	}
+	importValidatorKeys = cli.StringFlag{
+		Name: "import-validator-keys",
+		Usage: "path to a file containing already existing BLS keys that will be used as the first validators. " +
+			"The file can either be a PEM bundle (as the validatorKey.pem file) or a list of hex encoded public " +
+			"keys, one per line. Only the keys with known secret keys will be written in the validatorKey.pem file",
+	}
+	walletKeyFormat = cli.StringFlag{
+		Name: "wallet-key-format",
+		Usage: "defines how the wallet and delegator keys are written: 'keystore' as encrypted JSON keystore files " +
+			"(compatible with the MultiversX wallets) or 'pem' as plaintext PEM files, to be used only for local " +
+			"test networks",
+		Value: "keystore",
+	}
+	keystorePassphraseFile = cli.StringFlag{
+		Name: "keystore-passphrase-file",
+		Usage: "path to a file containing the passphrase used to encrypt the keystore files. If not set, the " +
+			"passphrase is read from the " + filegen.KeystorePassphraseEnvVar + " environment variable",
+	}
+	outputLayout = cli.StringFlag{
+		Name: "output-layout",
+		Usage: "defines how the validator and observer keys are written: 'classic' as a single validatorKey.pem file " +
+			"or 'per-node' as a node-<shard>-<index>/config/validatorKey.pem file for each node, the observers being " +
+			"grouped under their destination shard",
+		Value: "classic",
+	}
+	outputWriters = cli.StringFlag{
+		Name: "output-writers",
+		Usage: "comma separated list of the output writers fed with the generated network, each given as " +
+			"<name>[:<parameters>]. The registered writers are: " + registeredOutputWritersUsage(),
+		Value: core.ClassicOutputWriter,
+	}
+	scenarioFile = cli.StringFlag{
+		Name: "config",
+		Usage: "path to a versioned scenario file (.toml, .yaml, .yml or .json) describing the network to be generated. " +
+			"The flags explicitly provided in the command line will override the values from this file",
+	}
+
+	log = logger.GetOrCreate("main")
 )
 
 // The resulting binary will be used to generate 2 files: genesis.json and privkeys.pem
@@ -179,6 +347,7 @@ func main() {
 		outputDirectoryFlag,
 		totalSupply,
 		nodePrice,
+		denomination,
 		numOfShards,
 		numOfNodesPerShard,
 		consensusGroupSize,
@@ -188,16 +357,45 @@ func main() {
 		numOfMetachainObservers,
 		numAdditionalAccountsInGenesis,
 		initialRating,
+		ratingProfile,
+		ratingOverrides,
 		hysteresis,
 		adaptivity,
 		txgenFile,
 		stakeType,
 		delegationOwnerPublicKey,
 		numDelegators,
+		delegatorDistribution,
+		minDelegation,
+		delegationServiceFee,
+		delegationCap,
+		delegationInit,
+		delegationVersion,
 		richestAccount,
+		minOwnerBalance,
+		minDelegatorBalance,
+		minAdditionalBalance,
+		remainderSink,
+		treasuryAddress,
+		treasuryPercent,
+		allocationsFile,
+		snapshotFile,
+		snapshotSupply,
 		numDelegatedNodes,
 		maxNumValidatorsPerOwner,
+		ownerDistribution,
+		topUpPolicy,
 		roundDuration,
+		scenarioFile,
+		seed,
+		walletMnemonic,
+		walletMnemonicFile,
+		walletMnemonicPlaintext,
+		importValidatorKeys,
+		walletKeyFormat,
+		keystorePassphraseFile,
+		outputLayout,
+		outputWriters,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -206,6 +404,13 @@ func main() {
 		},
 	}
 
+	app.Commands = []cli.Command{
+		submitCommand,
+		assembleCommand,
+		verifyCommand,
+		extendCommand,
+	}
+
 	app.Action = func(c *cli.Context) error {
 		return generate(c)
 	}
@@ -218,152 +423,23 @@ func main() {
 }
 
 func generate(ctx *cli.Context) error {
-	var err error
-
 	startTime := time.Now()
-	outputDirectory := ctx.GlobalString(outputDirectoryFlag.Name)
-	numOfShardsValue := ctx.GlobalInt(numOfShards.Name)
-	numOfNodesPerShardValue := ctx.GlobalInt(numOfNodesPerShard.Name)
-	consensusGroupSizeValue := ctx.GlobalInt(consensusGroupSize.Name)
-	numOfObserversPerShardValue := ctx.GlobalInt(numOfObserversPerShard.Name)
-	numOfMetachainNodesValue := ctx.GlobalInt(numOfMetachainNodes.Name)
-	metachainConsensusGroupSizeValue := ctx.GlobalInt(metachainConsensusGroupSize.Name)
-	numOfMetachainObserversValue := ctx.GlobalInt(numOfMetachainObservers.Name)
-	numOfAdditionalAccountsValue := ctx.GlobalInt(numAdditionalAccountsInGenesis.Name)
-	initialRatingValue := ctx.GlobalUint64(initialRating.Name)
-	hysteresisValue := ctx.GlobalFloat64(hysteresis.Name)
-	adaptivityValue := ctx.GlobalBool(adaptivity.Name)
-	generateTxgenFile := ctx.IsSet(txgenFile.Name)
-	numDelegatorsValue := ctx.GlobalUint(numDelegators.Name)
-	withRichestAccount := ctx.GlobalBool(richestAccount.Name)
-	stakeTypeString := ctx.GlobalString(stakeType.Name)
-	delegationOwnerPkString := ctx.GlobalString(delegationOwnerPublicKey.Name)
-	numDelegatedNodesValue := ctx.GlobalUint(numDelegatedNodes.Name)
-	maxNumValidatorsPerOwnerValue := ctx.GlobalUint(maxNumValidatorsPerOwner.Name)
-	roundDurationValue := ctx.GlobalUint(roundDuration.Name)
-
-	err = prepareOutputDirectory(outputDirectory)
-	if err != nil {
-		return err
-	}
-
-	numValidatorsOnAShard := int(math.Ceil(float64(numOfNodesPerShardValue) * (1 + hysteresisValue)))
-	numShardValidators := numOfShardsValue * numValidatorsOnAShard
-	numValidatorsOnMeta := int(math.Ceil(float64(metachainConsensusGroupSizeValue) * (1 + hysteresisValue)))
-	numValidators := numShardValidators + numValidatorsOnMeta
-	numObservers := numOfShardsValue*numOfObserversPerShardValue + numOfMetachainObserversValue
-
-	invalidNumPrivPubKey := numValidators < 1 ||
-		numOfShardsValue < 1 ||
-		numOfNodesPerShardValue < 1 ||
-		numOfMetachainNodesValue < 1
-	if invalidNumPrivPubKey {
-		return errInvalidNumPrivPubKeys
-	}
-
-	invalidNumOfNodes := consensusGroupSizeValue < 1 ||
-		consensusGroupSizeValue > numOfNodesPerShardValue ||
-		numOfObserversPerShardValue < 0 ||
-		metachainConsensusGroupSizeValue < 1 ||
-		metachainConsensusGroupSizeValue > numOfMetachainNodesValue ||
-		numOfMetachainObserversValue < 0
-	if invalidNumOfNodes {
-		return errInvalidNumOfNodes
-	}
-
-	totalSupplyString := ctx.GlobalString(totalSupply.Name)
-	totalSupplyValue, err := core.ConvertToPositiveBigInt(totalSupplyString)
-	if err != nil {
-		return err
-	}
-
-	nodePriceString := ctx.GlobalString(nodePrice.Name)
-	nodePriceValue, err := core.ConvertToPositiveBigInt(nodePriceString)
-	if err != nil {
-		return err
-	}
-
-	validatorPubKeyConverter, walletPubKeyConverter, err := createPubKeyConverters()
+	scenario, err := loadScenarioConfig(ctx)
 	if err != nil {
 		return err
 	}
 
-	validatorKeyGenerator, walletKeyGenerator := createKeyGenerators()
-
-	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(numOfShardsValue), 0)
-	if err != nil {
-		return err
-	}
-
-	argOutputHandler, err := plugins.CreateOutputHandlerArgument(
-		outputDirectory,
-		validatorPubKeyConverter,
-		walletPubKeyConverter,
-		shardCoordinator,
-		generateTxgenFile,
-		stakeTypeString == core.DelegatedStakeType || stakeTypeString == core.MixedType,
-	)
-	if err != nil {
-		return err
-	}
-	argOutputHandler.RoundDuration = uint64(roundDurationValue)
-	argOutputHandler.ConsensusGroupSize = consensusGroupSizeValue
-	argOutputHandler.NumOfNodesPerShard = numOfNodesPerShardValue
-	argOutputHandler.MetachainConsensusGroupSize = metachainConsensusGroupSizeValue
-	argOutputHandler.NumOfMetachainNodes = numOfMetachainNodesValue
-	argOutputHandler.HysteresisValue = float32(hysteresisValue)
-	argOutputHandler.AdaptivityValue = adaptivityValue
-
-	outputHandler, err := plugins.NewOutputHandler(argOutputHandler)
-	if err != nil {
-		return err
-	}
-
-	defer outputHandler.Close()
-
-	argDataGenerator := factory.ArgDataGenerator{
-		KeyGeneratorForValidators: validatorKeyGenerator,
-		KeyGeneratorForWallets:    walletKeyGenerator,
-		WalletPubKeyConverter:     walletPubKeyConverter,
-		ValidatorPubKeyConverter:  validatorPubKeyConverter,
-		NumValidatorBlsKeys:       uint(numValidators),
-		NumObserverBlsKeys:        uint(numObservers),
-		RichestAccountMode:        withRichestAccount,
-		MaxNumNodesOnOwner:        maxNumValidatorsPerOwnerValue,
-		NumAdditionalWalletKeys:   uint(numOfAdditionalAccountsValue),
-		IntRandomizer:             &random.ConcurrentSafeIntRandomizer{},
-		NodePrice:                 nodePriceValue,
-		TotalSupply:               totalSupplyValue,
-		InitialRating:             initialRatingValue,
-		GenerationType:            stakeTypeString,
-		DelegationOwnerPkString:   delegationOwnerPkString,
-		DelegationOwnerNonce:      delegationOwnerNonce,
-		VmType:                    vmType,
-		NumDelegators:             numDelegatorsValue,
-		NumDelegatedNodes:         numDelegatedNodesValue,
-	}
-
-	dataGenerator, err := factory.CreateDataGenerator(argDataGenerator)
+	err = filegen.CheckKeystorePassphrase(scenario.Output)
 	if err != nil {
 		return err
 	}
 
-	generatedOutput, err := dataGenerator.Generate()
+	generatedOutput, err := filegen.Generate(context.Background(), scenario)
 	if err != nil {
 		return err
 	}
 
-	initialAccountChecker, err := check.NewInitialAccountsChecker(nodePriceValue, totalSupplyValue)
-	if err != nil {
-		return err
-	}
-
-	err = initialAccountChecker.CheckInitialAccounts(generatedOutput.InitialAccounts)
-	if err != nil {
-		return err
-	}
-
-	err = outputHandler.WriteData(*generatedOutput)
+	err = filegen.Write(scenario, generatedOutput)
 	if err != nil {
 		return err
 	}
@@ -373,42 +449,34 @@ func generate(ctx *cli.Context) error {
 	return nil
 }
 
-func prepareOutputDirectory(outputDirectory string) error {
-	_, err := os.Stat(outputDirectory)
-	if os.IsNotExist(err) {
-		return os.MkdirAll(outputDirectory, 0755)
-	}
-
-	return err
-}
-
-func createPubKeyConverters() (mxCore.PubkeyConverter, mxCore.PubkeyConverter, error) {
-	walletPubKeyConverter, err := mxCommonFactory.NewPubkeyConverter(config.PubkeyConfig{
-		Length: 32,
-		Type:   walletPubKeyFormat,
-		Hrp:    egldHrp,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("%w for walletPubKeyConverter", err)
-	}
+// registeredStakeTypesUsage describes the registered data generators, including the ones registered by the imported
+// packages, as the package level variables are initialized after the init functions of the imported packages
+func registeredStakeTypesUsage() string {
+	descriptions := make([]string, 0)
+	for _, definition := range factory.RegisteredDataGenerators() {
+		stakeTypeUsage := fmt.Sprintf("'%s'", definition.Name)
+		if len(definition.ParametersUsage) > 0 {
+			stakeTypeUsage = fmt.Sprintf("'%s:%s'", definition.Name, definition.ParametersUsage)
+		}
 
-	validatorPubKeyConverter, err := mxCommonFactory.NewPubkeyConverter(config.PubkeyConfig{
-		Length: 96,
-		Type:   validatorPubKeyFormat,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("%w for validatorPubKeyConverter", err)
+		descriptions = append(descriptions, fmt.Sprintf("%s - %s", stakeTypeUsage, definition.Description))
 	}
 
-	return validatorPubKeyConverter, walletPubKeyConverter, nil
+	return strings.Join(descriptions, "; ")
 }
 
-func createKeyGenerators() (crypto.KeyGenerator, crypto.KeyGenerator) {
-	walletSuite := ed25519.NewEd25519()
-	walletKeyGenerator := signing.NewKeyGenerator(walletSuite)
+// registeredOutputWritersUsage describes the registered output writers, including the ones registered by the imported
+// packages
+func registeredOutputWritersUsage() string {
+	descriptions := make([]string, 0)
+	for _, definition := range plugins.RegisteredOutputWriters() {
+		writerUsage := fmt.Sprintf("'%s'", definition.Name)
+		if len(definition.ParametersUsage) > 0 {
+			writerUsage = fmt.Sprintf("'%s[:%s]'", definition.Name, definition.ParametersUsage)
+		}
 
-	validatorSuite := mcl.NewSuiteBLS12()
-	validatorKeyGenerator := signing.NewKeyGenerator(validatorSuite)
+		descriptions = append(descriptions, fmt.Sprintf("%s - %s", writerUsage, definition.Description))
+	}
 
-	return validatorKeyGenerator, walletKeyGenerator
+	return strings.Join(descriptions, "; ")
 }
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/urfave/cli"
+)
+
+// loadScenarioConfig builds the scenario config in 3 layers: the flags default values, the optional scenario
+// file and, at last, the flags explicitly provided in the command line
+func loadScenarioConfig(ctx *cli.Context) (*config.ScenarioConfig, error) {
+	scenario := createDefaultScenarioConfig()
+
+	if ctx.GlobalIsSet(scenarioFile.Name) {
+		scenarioFilePath := ctx.GlobalString(scenarioFile.Name)
+		err := config.LoadScenarioConfig(scenarioFilePath, scenario)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("loaded scenario file", "path", scenarioFilePath, "version", scenario.Version)
+	}
+
+	applyFlagOverrides(ctx, scenario)
+
+	return scenario, nil
+}
+
+func createDefaultScenarioConfig() *config.ScenarioConfig {
+	return &config.ScenarioConfig{
+		Version: config.CurrentScenarioVersion,
+		Output: config.OutputConfig{
+			Directory:              outputDirectoryFlag.Value,
+			GenerateTxgenFile:      false,
+			WalletKeyFormat:        walletKeyFormat.Value,
+			KeystorePassphraseFile: keystorePassphraseFile.Value,
+			PlaintextMnemonic:      false,
+			Layout:                 outputLayout.Value,
+			Writers:                splitOutputWriters(outputWriters.Value),
+		},
+		Network: config.NetworkConfig{
+			NumOfShards:                 numOfShards.Value,
+			NumOfNodesInEachShard:       numOfNodesPerShard.Value,
+			ConsensusGroupSize:          consensusGroupSize.Value,
+			NumOfObserversInEachShard:   numOfObserversPerShard.Value,
+			NumOfMetachainNodes:         numOfMetachainNodes.Value,
+			MetachainConsensusGroupSize: metachainConsensusGroupSize.Value,
+			NumOfObserversInMetachain:   numOfMetachainObservers.Value,
+			Hysteresis:                  hysteresis.Value,
+			Adaptivity:                  false,
+			RoundDuration:               uint64(roundDuration.Value),
+			InitialRating:               initialRating.Value,
+			RatingProfile:               ratingProfile.Value,
+			RatingOverrides:             ratingOverrides.Value,
+		},
+		Economics: config.EconomicsConfig{
+			TotalSupply:           totalSupply.Value,
+			NodePrice:             nodePrice.Value,
+			Denomination:          denomination.Value,
+			NumAdditionalAccounts: numAdditionalAccountsInGenesis.Value,
+			RichestAccount:        false,
+			MinOwnerBalance:       minOwnerBalance.Value,
+			MinDelegatorBalance:   minDelegatorBalance.Value,
+			MinAdditionalBalance:  minAdditionalBalance.Value,
+			RemainderSink:         remainderSink.Value,
+			TreasuryAddress:       treasuryAddress.Value,
+			TreasuryPercent:       treasuryPercent.Value,
+			AllocationsFile:       allocationsFile.Value,
+			SnapshotFile:          snapshotFile.Value,
+			SnapshotSupply:        snapshotSupply.Value,
+		},
+		Staking: config.StakingConfig{
+			StakeType:                stakeType.Value,
+			DelegationOwnerPublicKey: delegationOwnerPublicKey.Value,
+			NumDelegators:            numDelegators.Value,
+			NumDelegatedNodes:        numDelegatedNodes.Value,
+			MaxNumValidatorsPerOwner: maxNumValidatorsPerOwner.Value,
+			OwnerDistribution:        ownerDistribution.Value,
+			TopUpPolicy:              topUpPolicy.Value,
+			DelegatorDistribution:    delegatorDistribution.Value,
+			MinDelegation:            minDelegation.Value,
+			DelegationServiceFee:     delegationServiceFee.Value,
+			DelegationCap:            delegationCap.Value,
+			DelegationInit:           delegationInit.Value,
+			DelegationVersion:        delegationVersion.Value,
+		},
+		Keys: config.KeysConfig{
+			Seed:              seed.Value,
+			UseMnemonic:       false,
+			MnemonicFile:      walletMnemonicFile.Value,
+			ValidatorKeysFile: importValidatorKeys.Value,
+		},
+	}
+}
+
+func applyFlagOverrides(ctx *cli.Context, scenario *config.ScenarioConfig) {
+	if ctx.GlobalIsSet(outputDirectoryFlag.Name) {
+		scenario.Output.Directory = ctx.GlobalString(outputDirectoryFlag.Name)
+	}
+	if ctx.GlobalIsSet(txgenFile.Name) {
+		scenario.Output.GenerateTxgenFile = ctx.GlobalBool(txgenFile.Name)
+	}
+	if ctx.GlobalIsSet(walletKeyFormat.Name) {
+		scenario.Output.WalletKeyFormat = ctx.GlobalString(walletKeyFormat.Name)
+	}
+	if ctx.GlobalIsSet(keystorePassphraseFile.Name) {
+		scenario.Output.KeystorePassphraseFile = ctx.GlobalString(keystorePassphraseFile.Name)
+	}
+	if ctx.GlobalIsSet(walletMnemonicPlaintext.Name) {
+		scenario.Output.PlaintextMnemonic = ctx.GlobalBool(walletMnemonicPlaintext.Name)
+	}
+	if ctx.GlobalIsSet(outputLayout.Name) {
+		scenario.Output.Layout = ctx.GlobalString(outputLayout.Name)
+	}
+	if ctx.GlobalIsSet(outputWriters.Name) {
+		scenario.Output.Writers = splitOutputWriters(ctx.GlobalString(outputWriters.Name))
+	}
+
+	if ctx.GlobalIsSet(numOfShards.Name) {
+		scenario.Network.NumOfShards = ctx.GlobalInt(numOfShards.Name)
+	}
+	if ctx.GlobalIsSet(numOfNodesPerShard.Name) {
+		scenario.Network.NumOfNodesInEachShard = ctx.GlobalInt(numOfNodesPerShard.Name)
+	}
+	if ctx.GlobalIsSet(consensusGroupSize.Name) {
+		scenario.Network.ConsensusGroupSize = ctx.GlobalInt(consensusGroupSize.Name)
+	}
+	if ctx.GlobalIsSet(numOfObserversPerShard.Name) {
+		scenario.Network.NumOfObserversInEachShard = ctx.GlobalInt(numOfObserversPerShard.Name)
+	}
+	if ctx.GlobalIsSet(numOfMetachainNodes.Name) {
+		scenario.Network.NumOfMetachainNodes = ctx.GlobalInt(numOfMetachainNodes.Name)
+	}
+	if ctx.GlobalIsSet(metachainConsensusGroupSize.Name) {
+		scenario.Network.MetachainConsensusGroupSize = ctx.GlobalInt(metachainConsensusGroupSize.Name)
+	}
+	if ctx.GlobalIsSet(numOfMetachainObservers.Name) {
+		scenario.Network.NumOfObserversInMetachain = ctx.GlobalInt(numOfMetachainObservers.Name)
+	}
+	if ctx.GlobalIsSet(hysteresis.Name) {
+		scenario.Network.Hysteresis = ctx.GlobalFloat64(hysteresis.Name)
+	}
+	if ctx.GlobalIsSet(adaptivity.Name) {
+		scenario.Network.Adaptivity = ctx.GlobalBool(adaptivity.Name)
+	}
+	if ctx.GlobalIsSet(roundDuration.Name) {
+		scenario.Network.RoundDuration = uint64(ctx.GlobalUint(roundDuration.Name))
+	}
+	if ctx.GlobalIsSet(initialRating.Name) {
+		scenario.Network.InitialRating = ctx.GlobalUint64(initialRating.Name)
+	}
+	if ctx.GlobalIsSet(ratingProfile.Name) {
+		scenario.Network.RatingProfile = ctx.GlobalString(ratingProfile.Name)
+	}
+	if ctx.GlobalIsSet(ratingOverrides.Name) {
+		scenario.Network.RatingOverrides = ctx.GlobalString(ratingOverrides.Name)
+	}
+
+	if ctx.GlobalIsSet(totalSupply.Name) {
+		scenario.Economics.TotalSupply = ctx.GlobalString(totalSupply.Name)
+	}
+	if ctx.GlobalIsSet(nodePrice.Name) {
+		scenario.Economics.NodePrice = ctx.GlobalString(nodePrice.Name)
+	}
+	if ctx.GlobalIsSet(denomination.Name) {
+		scenario.Economics.Denomination = ctx.GlobalInt(denomination.Name)
+	}
+	if ctx.GlobalIsSet(numAdditionalAccountsInGenesis.Name) {
+		scenario.Economics.NumAdditionalAccounts = ctx.GlobalInt(numAdditionalAccountsInGenesis.Name)
+	}
+	if ctx.GlobalIsSet(richestAccount.Name) {
+		scenario.Economics.RichestAccount = ctx.GlobalBool(richestAccount.Name)
+	}
+	if ctx.GlobalIsSet(minOwnerBalance.Name) {
+		scenario.Economics.MinOwnerBalance = ctx.GlobalString(minOwnerBalance.Name)
+	}
+	if ctx.GlobalIsSet(minDelegatorBalance.Name) {
+		scenario.Economics.MinDelegatorBalance = ctx.GlobalString(minDelegatorBalance.Name)
+	}
+	if ctx.GlobalIsSet(minAdditionalBalance.Name) {
+		scenario.Economics.MinAdditionalBalance = ctx.GlobalString(minAdditionalBalance.Name)
+	}
+	if ctx.GlobalIsSet(remainderSink.Name) {
+		scenario.Economics.RemainderSink = ctx.GlobalString(remainderSink.Name)
+	}
+	if ctx.GlobalIsSet(treasuryAddress.Name) {
+		scenario.Economics.TreasuryAddress = ctx.GlobalString(treasuryAddress.Name)
+	}
+	if ctx.GlobalIsSet(treasuryPercent.Name) {
+		scenario.Economics.TreasuryPercent = ctx.GlobalFloat64(treasuryPercent.Name)
+	}
+	if ctx.GlobalIsSet(allocationsFile.Name) {
+		scenario.Economics.AllocationsFile = ctx.GlobalString(allocationsFile.Name)
+	}
+	if ctx.GlobalIsSet(snapshotFile.Name) {
+		scenario.Economics.SnapshotFile = ctx.GlobalString(snapshotFile.Name)
+	}
+	if ctx.GlobalIsSet(snapshotSupply.Name) {
+		scenario.Economics.SnapshotSupply = ctx.GlobalString(snapshotSupply.Name)
+	}
+
+	if ctx.GlobalIsSet(stakeType.Name) {
+		scenario.Staking.StakeType = ctx.GlobalString(stakeType.Name)
+	}
+	if ctx.GlobalIsSet(delegationOwnerPublicKey.Name) {
+		scenario.Staking.DelegationOwnerPublicKey = ctx.GlobalString(delegationOwnerPublicKey.Name)
+	}
+	if ctx.GlobalIsSet(numDelegators.Name) {
+		scenario.Staking.NumDelegators = ctx.GlobalUint(numDelegators.Name)
+	}
+	if ctx.GlobalIsSet(numDelegatedNodes.Name) {
+		scenario.Staking.NumDelegatedNodes = ctx.GlobalUint(numDelegatedNodes.Name)
+	}
+	if ctx.GlobalIsSet(maxNumValidatorsPerOwner.Name) {
+		scenario.Staking.MaxNumValidatorsPerOwner = ctx.GlobalUint(maxNumValidatorsPerOwner.Name)
+	}
+	if ctx.GlobalIsSet(ownerDistribution.Name) {
+		scenario.Staking.OwnerDistribution = ctx.GlobalString(ownerDistribution.Name)
+	}
+	if ctx.GlobalIsSet(topUpPolicy.Name) {
+		scenario.Staking.TopUpPolicy = ctx.GlobalString(topUpPolicy.Name)
+	}
+	if ctx.GlobalIsSet(delegatorDistribution.Name) {
+		scenario.Staking.DelegatorDistribution = ctx.GlobalString(delegatorDistribution.Name)
+	}
+	if ctx.GlobalIsSet(minDelegation.Name) {
+		scenario.Staking.MinDelegation = ctx.GlobalString(minDelegation.Name)
+	}
+	if ctx.GlobalIsSet(delegationServiceFee.Name) {
+		scenario.Staking.DelegationServiceFee = ctx.GlobalUint64(delegationServiceFee.Name)
+	}
+	if ctx.GlobalIsSet(delegationCap.Name) {
+		scenario.Staking.DelegationCap = ctx.GlobalString(delegationCap.Name)
+	}
+	if ctx.GlobalIsSet(delegationInit.Name) {
+		scenario.Staking.DelegationInit = ctx.GlobalString(delegationInit.Name)
+	}
+	if ctx.GlobalIsSet(delegationVersion.Name) {
+		scenario.Staking.DelegationVersion = ctx.GlobalString(delegationVersion.Name)
+	}
+
+	if ctx.GlobalIsSet(seed.Name) {
+		scenario.Keys.Seed = ctx.GlobalString(seed.Name)
+	}
+	if ctx.GlobalIsSet(walletMnemonic.Name) {
+		scenario.Keys.UseMnemonic = ctx.GlobalBool(walletMnemonic.Name)
+	}
+	if ctx.GlobalIsSet(walletMnemonicFile.Name) {
+		scenario.Keys.MnemonicFile = ctx.GlobalString(walletMnemonicFile.Name)
+	}
+	if ctx.GlobalIsSet(importValidatorKeys.Name) {
+		scenario.Keys.ValidatorKeysFile = ctx.GlobalString(importValidatorKeys.Name)
+	}
+}
+
+func splitOutputWriters(value string) []string {
+	writers := make([]string, 0)
+	for _, writer := range strings.Split(value, ",") {
+		writer = strings.TrimSpace(writer)
+		if len(writer) > 0 {
+			writers = append(writers, writer)
+		}
+	}
+
+	return writers
+}
@@ -0,0 +1,606 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-deploy-go/check"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	"github.com/multiversx/mx-chain-deploy-go/filegen"
+	"github.com/multiversx/mx-chain-deploy-go/generate/deterministic"
+	"github.com/multiversx/mx-chain-deploy-go/plugins"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/urfave/cli"
+)
+
+const (
+	checkPassed  = "passed"
+	checkFailed  = "failed"
+	checkSkipped = "skipped"
+
+	requiredCheck = true
+	optionalCheck = false
+)
+
+var (
+	verifyDirectory = cli.StringFlag{
+		Name:  "dir",
+		Usage: "the output directory to be verified. If not set, the output directory of the scenario will be used",
+	}
+	verifyReportFile = cli.StringFlag{
+		Name:  "report-file",
+		Usage: "optional path of a file in which the verification report will be written as JSON",
+	}
+
+	verifyCommand = cli.Command{
+		Name: "verify",
+		Usage: "reloads all the files from an existing output directory, checks that each PEM or keystore secret key " +
+			"derives its public key and runs the genesis and nodes setup checks, using the global options for the " +
+			"economics parameters and the keystore passphrase. Exits with a non-zero code if any check fails",
+		Flags: []cli.Flag{
+			verifyDirectory,
+			verifyReportFile,
+		},
+		Action: verify,
+	}
+
+	errVerificationFailed = errors.New("verification failed")
+	errMissingDependency  = errors.New("missing dependency")
+)
+
+type generatedOutputLoader interface {
+	LoadInitialAccounts() ([]mxData.InitialAccount, error)
+	LoadNodesSetup() (*sharding.NodesSetup, error)
+	LoadValidatorKeys() ([]*data.BlsKey, error)
+	LoadWalletKeys() ([]*data.WalletKey, error)
+	LoadDelegatorKeys() ([]*data.WalletKey, error)
+	LoadWalletKeystores(passphrase string) ([]*data.WalletKey, error)
+	LoadDelegatorKeystores(passphrase string) ([]*data.WalletKey, error)
+	LoadAdditionalKeys() ([]*data.WalletKey, error)
+	LoadGenesisSmartContracts() ([]*mxData.InitialSmartContract, error)
+	LoadShardMap() (*plugins.ShardMap, error)
+	LoadWalletMnemonic(passphrase string) (*plugins.WalletMnemonic, error)
+}
+
+type verifyCheckResult struct {
+	Name       string             `json:"name"`
+	Status     string             `json:"status"`
+	Details    string             `json:"details,omitempty"`
+	Errors     []string           `json:"errors,omitempty"`
+	Violations []*check.Violation `json:"violations,omitempty"`
+}
+
+type verifyReport struct {
+	Directory string               `json:"directory"`
+	Passed    bool                 `json:"passed"`
+	Checks    []*verifyCheckResult `json:"checks"`
+}
+
+type outputVerifier struct {
+	loader                   generatedOutputLoader
+	walletPubKeyConverter    mxCore.PubkeyConverter
+	validatorPubKeyConverter mxCore.PubkeyConverter
+	nodePrice                *big.Int
+	totalSupply              *big.Int
+	denomination             int
+	keystorePassphrase       string
+	keystorePassphraseErr    error
+	report                   *verifyReport
+
+	validatorKeys   []*data.BlsKey
+	walletKeys      []*data.WalletKey
+	delegatorKeys   []*data.WalletKey
+	initialAccounts []mxData.InitialAccount
+	nodesSetup      *sharding.NodesSetup
+}
+
+func verify(ctx *cli.Context) error {
+	scenario, err := loadScenarioConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	outputDirectory := scenario.Output.Directory
+	if ctx.IsSet(verifyDirectory.Name) {
+		outputDirectory = ctx.String(verifyDirectory.Name)
+	}
+
+	denominationValue := scenario.Economics.Denomination
+	totalSupplyValue, err := core.ConvertAmount(scenario.Economics.TotalSupply, denominationValue)
+	if err != nil {
+		return fmt.Errorf("%w for the total supply", err)
+	}
+	nodePriceValue, err := core.ConvertAmount(scenario.Economics.NodePrice, denominationValue)
+	if err != nil {
+		return fmt.Errorf("%w for the node price", err)
+	}
+
+	validatorPubKeyConverter, walletPubKeyConverter, err := filegen.CreatePubKeyConverters()
+	if err != nil {
+		return err
+	}
+
+	// the passphrase is only needed if the wallet keys are stored in keystore files
+	keystorePassphrase, keystorePassphraseErr := filegen.ReadKeystorePassphrase(scenario.Output)
+
+	loader, err := plugins.NewOutputLoader(plugins.ArgOutputLoader{
+		OutputDirectory:          outputDirectory,
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+		WalletPubKeyConverter:    walletPubKeyConverter,
+		NumOfShards:              uint32(scenario.Network.NumOfShards),
+	})
+	if err != nil {
+		return err
+	}
+
+	verifier := &outputVerifier{
+		loader:                   loader,
+		walletPubKeyConverter:    walletPubKeyConverter,
+		validatorPubKeyConverter: validatorPubKeyConverter,
+		nodePrice:                nodePriceValue,
+		totalSupply:              totalSupplyValue,
+		denomination:             denominationValue,
+		keystorePassphrase:       keystorePassphrase,
+		keystorePassphraseErr:    keystorePassphraseErr,
+		report: &verifyReport{
+			Directory: outputDirectory,
+			Passed:    true,
+		},
+	}
+	report := verifier.verify()
+
+	fmt.Print(report.String())
+	if ctx.IsSet(verifyReportFile.Name) {
+		err = writeVerifyReport(ctx.String(verifyReportFile.Name), report)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !report.Passed {
+		return fmt.Errorf("%w for %s", errVerificationFailed, outputDirectory)
+	}
+
+	log.Info("output directory verified successfully!", "directory", outputDirectory)
+	return nil
+}
+
+func writeVerifyReport(filePath string, report *verifyReport) error {
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, buff, 0644)
+}
+
+// verify will run all the checks, in order. The genesis, the nodes setup and the validator keys are required, while
+// the other checks are skipped if the files they rely on are missing
+func (ov *outputVerifier) verify() *verifyReport {
+	ov.run("validator keys", requiredCheck, ov.checkValidatorKeys)
+	ov.run("wallet keys", optionalCheck, ov.checkWalletKeys)
+	ov.run("delegator keys", optionalCheck, ov.checkDelegatorKeys)
+	ov.run("genesis accounts", requiredCheck, ov.checkInitialAccounts)
+	ov.run("nodes setup", requiredCheck, ov.checkNodesSetup)
+	ov.run("stake consistency", optionalCheck, ov.checkConsistency)
+	ov.run("genesis smart contracts", optionalCheck, ov.checkGenesisSmartContracts)
+	ov.run("shard map", optionalCheck, ov.checkShardMap)
+	ov.run("txgen accounts", optionalCheck, ov.checkTxgenAccounts)
+	ov.run("wallet mnemonic", optionalCheck, ov.checkWalletMnemonic)
+
+	return ov.report
+}
+
+func (ov *outputVerifier) run(name string, required bool, handler func() (string, error)) {
+	details, err := handler()
+	result := &verifyCheckResult{
+		Name:    name,
+		Status:  checkPassed,
+		Details: details,
+	}
+
+	switch {
+	case err == nil:
+	case required && errors.Is(err, os.ErrNotExist):
+		result.Status = checkFailed
+		result.Errors = []string{fmt.Sprintf("%s, the file is required", err.Error())}
+		ov.report.Passed = false
+	case errors.Is(err, os.ErrNotExist), errors.Is(err, errMissingDependency):
+		result.Status = checkSkipped
+		result.Details = err.Error()
+	default:
+		result.Status = checkFailed
+		result.Errors = []string{err.Error()}
+		ov.report.Passed = false
+	}
+
+	reportError := &check.ReportError{}
+	if errors.As(err, &reportError) {
+		result.Violations = reportError.Report.Violations
+		result.Errors = make([]string, 0, len(result.Violations))
+		for _, violation := range result.Violations {
+			result.Errors = append(result.Errors, violation.Error())
+		}
+	}
+
+	ov.report.Checks = append(ov.report.Checks, result)
+}
+
+func (ov *outputVerifier) checkValidatorKeys() (string, error) {
+	keys, err := ov.loader.LoadValidatorKeys()
+	if err != nil {
+		return "", err
+	}
+	ov.validatorKeys = keys
+
+	return fmt.Sprintf("%d keys, each secret key derives its public key", len(keys)), nil
+}
+
+func (ov *outputVerifier) checkWalletKeys() (string, error) {
+	keys, source, err := ov.loadWalletKeys("wallet", ov.loader.LoadWalletKeys, ov.loader.LoadWalletKeystores)
+	if err != nil {
+		return "", err
+	}
+	ov.walletKeys = keys
+
+	return fmt.Sprintf("%d keys %s, each secret key derives its address", len(keys), source), nil
+}
+
+func (ov *outputVerifier) checkDelegatorKeys() (string, error) {
+	keys, source, err := ov.loadWalletKeys("delegator", ov.loader.LoadDelegatorKeys, ov.loader.LoadDelegatorKeystores)
+	if err != nil {
+		return "", err
+	}
+	ov.delegatorKeys = keys
+
+	return fmt.Sprintf("%d keys %s, each secret key derives its address", len(keys), source), nil
+}
+
+// loadWalletKeys will load the keys of the provided kind either from the PEM file or, decrypting them with the
+// keystore passphrase, from the keystore files. The keystores can not be skipped, so a missing passphrase fails
+func (ov *outputVerifier) loadWalletKeys(
+	kind string,
+	loadPemKeys func() ([]*data.WalletKey, error),
+	loadKeystoreKeys func(passphrase string) ([]*data.WalletKey, error),
+) ([]*data.WalletKey, string, error) {
+	keys, err := loadPemKeys()
+	if err == nil {
+		return keys, "from the PEM file", nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, "", fmt.Errorf("%w, no %s keys were written", err, kind)
+	}
+	if !errors.Is(err, plugins.ErrEncryptedKeystoreFiles) {
+		return nil, "", err
+	}
+
+	if ov.keystorePassphraseErr != nil {
+		return nil, "", fmt.Errorf("%w, the %s keystores can not be decrypted: %s", errVerificationFailed, kind,
+			ov.keystorePassphraseErr.Error())
+	}
+	keys, err = loadKeystoreKeys(ov.keystorePassphrase)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return keys, "from the keystore files", nil
+}
+
+func (ov *outputVerifier) checkInitialAccounts() (string, error) {
+	initialAccounts, err := ov.loader.LoadInitialAccounts()
+	if err != nil {
+		return "", err
+	}
+
+	initialAccountChecker, err := check.NewInitialAccountsChecker(ov.nodePrice, ov.totalSupply, ov.denomination)
+	if err != nil {
+		return "", err
+	}
+
+	err = initialAccountChecker.CheckInitialAccounts(initialAccounts)
+	if err != nil {
+		return "", err
+	}
+	ov.initialAccounts = initialAccounts
+
+	return fmt.Sprintf("%d accounts, total supply %s", len(initialAccounts),
+		core.FormatAmount(core.ComputeTotalSupply(initialAccounts), ov.denomination)), nil
+}
+
+func (ov *outputVerifier) checkNodesSetup() (string, error) {
+	nodesSetup, err := ov.loader.LoadNodesSetup()
+	if err != nil {
+		return "", err
+	}
+	ov.nodesSetup = nodesSetup
+
+	return fmt.Sprintf("%d initial nodes in %d shards and the metachain", len(nodesSetup.InitialNodes),
+		nodesSetup.NumberOfShards()), nil
+}
+
+// checkConsistency reconciles the initial nodes with the stake of the genesis accounts and checks that all the
+// loaded wallet keys own a genesis account
+func (ov *outputVerifier) checkConsistency() (string, error) {
+	if ov.initialAccounts == nil || ov.nodesSetup == nil {
+		return "", fmt.Errorf("%w, both the genesis accounts and the nodes setup have to be valid", errMissingDependency)
+	}
+
+	generatedOutput := &data.GeneratorOutput{
+		InitialAccounts: ov.initialAccounts,
+		InitialNodes:    ov.nodesSetup.InitialNodes,
+	}
+	nodesPubKeys := make(map[string]struct{}, len(ov.nodesSetup.InitialNodes))
+	for _, node := range ov.nodesSetup.InitialNodes {
+		nodesPubKeys[string(node.PubKeyBytes())] = struct{}{}
+		generatedOutput.ValidatorBlsKeys = append(generatedOutput.ValidatorBlsKeys, &data.BlsKey{
+			PubKeyBytes: node.PubKeyBytes(),
+		})
+	}
+	for _, key := range ov.validatorKeys {
+		_, isValidator := nodesPubKeys[string(key.PubKeyBytes)]
+		if !isValidator {
+			generatedOutput.ObserverBlsKeys = append(generatedOutput.ObserverBlsKeys, key)
+		}
+	}
+
+	generatorOutputChecker, err := check.NewGeneratorOutputChecker(check.ArgGeneratorOutputChecker{
+		NodePrice:                ov.nodePrice,
+		WalletPubKeyConverter:    ov.walletPubKeyConverter,
+		ValidatorPubKeyConverter: ov.validatorPubKeyConverter,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = generatorOutputChecker.CheckGeneratorOutput(generatedOutput)
+	if err != nil {
+		return "", err
+	}
+
+	err = ov.checkWalletKeysAccounts()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d validators and %d observers", len(generatedOutput.ValidatorBlsKeys),
+		len(generatedOutput.ObserverBlsKeys)), nil
+}
+
+// checkWalletKeysAccounts checks that every loaded wallet or delegator key owns a genesis account and, if the wallet
+// keys were loaded, that every staking genesis account has its wallet key, so the keys match the genesis accounts
+func (ov *outputVerifier) checkWalletKeysAccounts() error {
+	addresses := make(map[string]struct{}, len(ov.initialAccounts))
+	for _, ia := range ov.initialAccounts {
+		addresses[ia.Address] = struct{}{}
+	}
+
+	keysAddresses := make(map[string]struct{}, len(ov.walletKeys)+len(ov.delegatorKeys))
+	for _, key := range append(append(make([]*data.WalletKey, 0), ov.walletKeys...), ov.delegatorKeys...) {
+		address, _ := ov.walletPubKeyConverter.Encode(key.PubKeyBytes)
+		_, found := addresses[address]
+		if !found {
+			return fmt.Errorf("%w, the wallet key %s has no genesis account", errVerificationFailed, address)
+		}
+		_, found = keysAddresses[address]
+		if found {
+			return fmt.Errorf("%w, the wallet key %s was loaded more than once", errVerificationFailed, address)
+		}
+		keysAddresses[address] = struct{}{}
+	}
+
+	if ov.walletKeys == nil {
+		return nil
+	}
+	for _, ia := range ov.initialAccounts {
+		if ia.StakingValue == nil || ia.StakingValue.Sign() == 0 {
+			continue
+		}
+
+		_, found := keysAddresses[ia.Address]
+		if !found {
+			return fmt.Errorf("%w, the staking genesis account %s has no wallet key", errVerificationFailed, ia.Address)
+		}
+	}
+
+	return nil
+}
+
+// checkGenesisSmartContracts checks that every delegation address used in the genesis accounts is deployed by one
+// of the genesis smart contracts. The owners nonces are assigned in the order the contracts are defined
+func (ov *outputVerifier) checkGenesisSmartContracts() (string, error) {
+	contracts, err := ov.loader.LoadGenesisSmartContracts()
+	if err != nil {
+		return "", err
+	}
+	if ov.initialAccounts == nil {
+		return "", fmt.Errorf("%w, the genesis accounts have to be valid", errMissingDependency)
+	}
+
+	ownersNonces := make(map[string]uint64)
+	deployedAddresses := make(map[string]struct{}, len(contracts))
+	for i, contract := range contracts {
+		scAddress, errGenerate := core.GenerateSCAddress(
+			contract.Owner,
+			ownersNonces[contract.Owner],
+			contract.VmType,
+			ov.walletPubKeyConverter,
+		)
+		if errGenerate != nil {
+			return "", fmt.Errorf("%w for the contract at index %d, owner %s", errGenerate, i, contract.Owner)
+		}
+
+		ownersNonces[contract.Owner]++
+		deployedAddresses[scAddress] = struct{}{}
+	}
+
+	for _, ia := range ov.initialAccounts {
+		if len(ia.Delegation.Address) == 0 {
+			continue
+		}
+
+		_, found := deployedAddresses[ia.Delegation.Address]
+		if !found {
+			return "", fmt.Errorf("%w, the address %s delegates to %s, which is not deployed by any genesis contract",
+				errVerificationFailed, ia.Address, ia.Delegation.Address)
+		}
+	}
+
+	return fmt.Sprintf("%d contracts", len(contracts)), nil
+}
+
+// checkShardMap checks that the shard map manifest contains every initial node and every validator key
+func (ov *outputVerifier) checkShardMap() (string, error) {
+	manifest, err := ov.loader.LoadShardMap()
+	if err != nil {
+		return "", err
+	}
+	if ov.nodesSetup == nil {
+		return "", fmt.Errorf("%w, the nodes setup has to be valid", errMissingDependency)
+	}
+
+	if manifest.NumOfShards != ov.nodesSetup.NumberOfShards() {
+		return "", fmt.Errorf("%w, the shard map contains %d shards, while the nodes setup defines %d shards",
+			errVerificationFailed, manifest.NumOfShards, ov.nodesSetup.NumberOfShards())
+	}
+
+	mappedNodes := make(map[string]*plugins.ShardMapNode, len(manifest.Nodes))
+	for _, node := range manifest.Nodes {
+		_, found := mappedNodes[node.PubKey]
+		if found {
+			return "", fmt.Errorf("%w, the key %s is mapped more than once", errVerificationFailed, node.PubKey)
+		}
+		mappedNodes[node.PubKey] = node
+	}
+
+	for _, initialNode := range ov.nodesSetup.InitialNodes {
+		node, found := mappedNodes[initialNode.PubKey]
+		if !found {
+			return "", fmt.Errorf("%w, the initial node %s is missing from the shard map",
+				errVerificationFailed, initialNode.PubKey)
+		}
+		if node.Owner != initialNode.Address {
+			return "", fmt.Errorf("%w, the initial node %s is owned by %s, but the shard map contains %s",
+				errVerificationFailed, initialNode.PubKey, initialNode.Address, node.Owner)
+		}
+	}
+	for _, key := range ov.validatorKeys {
+		pkString, _ := ov.validatorPubKeyConverter.Encode(key.PubKeyBytes)
+		_, found := mappedNodes[pkString]
+		if !found {
+			return "", fmt.Errorf("%w, the validator key %s is missing from the shard map", errVerificationFailed, pkString)
+		}
+	}
+
+	return fmt.Sprintf("%d nodes in %d shards and the metachain", len(manifest.Nodes), manifest.NumOfShards), nil
+}
+
+// checkTxgenAccounts checks that every txgen account has a genesis account with the same balance
+func (ov *outputVerifier) checkTxgenAccounts() (string, error) {
+	keys, err := ov.loader.LoadAdditionalKeys()
+	if err != nil {
+		return "", err
+	}
+	if ov.initialAccounts == nil {
+		return "", fmt.Errorf("%w, the genesis accounts have to be valid", errMissingDependency)
+	}
+
+	balances := make(map[string]*big.Int, len(ov.initialAccounts))
+	for _, ia := range ov.initialAccounts {
+		balances[ia.Address] = ia.Balance
+	}
+	for _, key := range keys {
+		address, _ := ov.walletPubKeyConverter.Encode(key.PubKeyBytes)
+		balance, found := balances[address]
+		if !found {
+			return "", fmt.Errorf("%w, the txgen account %s has no genesis account", errVerificationFailed, address)
+		}
+		if balance.Cmp(key.Balance) != 0 {
+			return "", fmt.Errorf("%w, the txgen account %s has the balance %s, while the genesis contains %s",
+				errVerificationFailed, address, key.Balance.String(), balance.String())
+		}
+	}
+
+	return fmt.Sprintf("%d accounts", len(keys)), nil
+}
+
+// checkWalletMnemonic derives again each listed account from the mnemonic, decrypted with the keystore passphrase if
+// needed, and compares the resulting addresses
+func (ov *outputVerifier) checkWalletMnemonic() (string, error) {
+	mnemonic, err := ov.loader.LoadWalletMnemonic(ov.keystorePassphrase)
+	if err != nil {
+		return "", err
+	}
+
+	mnemonicKeyGen, err := deterministic.NewMnemonicKeyGenerator(
+		signing.NewKeyGenerator(ed25519.NewEd25519()),
+		mnemonic.Mnemonic,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	numIndexes := uint32(0)
+	for _, account := range mnemonic.Accounts {
+		if account.Index >= numIndexes {
+			numIndexes = account.Index + 1
+		}
+	}
+	derivedAddresses := make([]string, 0, numIndexes)
+	for i := uint32(0); i < numIndexes; i++ {
+		_, pk := mnemonicKeyGen.GeneratePair()
+		pkBytes, _ := pk.ToByteArray()
+		address, _ := ov.walletPubKeyConverter.Encode(pkBytes)
+		derivedAddresses = append(derivedAddresses, address)
+	}
+
+	for _, account := range mnemonic.Accounts {
+		if derivedAddresses[account.Index] != account.Address {
+			return "", fmt.Errorf("%w, the %s account %s is not derived at index %d, found %s",
+				errVerificationFailed, account.Kind, account.Address, account.Index, derivedAddresses[account.Index])
+		}
+	}
+
+	return fmt.Sprintf("%d accounts derived from the mnemonic", len(mnemonic.Accounts)), nil
+}
+
+// String returns the human readable form of the report
+func (report *verifyReport) String() string {
+	builder := &strings.Builder{}
+	_, _ = fmt.Fprintf(builder, "verification report for %s\n", report.Directory)
+
+	numFailed := 0
+	for _, result := range report.Checks {
+		line := fmt.Sprintf("  %-9s %s", "["+strings.ToUpper(result.Status)+"]", result.Name)
+		if len(result.Details) > 0 {
+			line += ": " + result.Details
+		}
+		_, _ = fmt.Fprintln(builder, line)
+
+		for _, errString := range result.Errors {
+			_, _ = fmt.Fprintf(builder, "            - %s\n", errString)
+		}
+		if result.Status == checkFailed {
+			numFailed++
+		}
+	}
+
+	status := strings.ToUpper(checkPassed)
+	if !report.Passed {
+		status = strings.ToUpper(checkFailed)
+	}
+	_, _ = fmt.Fprintf(builder, "result: %s, %d of %d checks failed\n", status, numFailed, len(report.Checks))
+
+	return builder.String()
+}
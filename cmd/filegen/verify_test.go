@@ -0,0 +1,208 @@
+package main
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/filegen"
+	"github.com/multiversx/mx-chain-deploy-go/plugins"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestOutputVerifier(t *testing.T, outputDirectory string) *outputVerifier {
+	validatorPubKeyConverter, walletPubKeyConverter, err := filegen.CreatePubKeyConverters()
+	require.Nil(t, err)
+
+	loader, err := plugins.NewOutputLoader(plugins.ArgOutputLoader{
+		OutputDirectory:          outputDirectory,
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+		WalletPubKeyConverter:    walletPubKeyConverter,
+		NumOfShards:              2,
+	})
+	require.Nil(t, err)
+
+	nodePrice, _ := big.NewInt(0).SetString("2500000000000000000000", 10)
+	totalSupply, _ := big.NewInt(0).SetString("20000000000000000000000000", 10)
+
+	return &outputVerifier{
+		loader:                   loader,
+		walletPubKeyConverter:    walletPubKeyConverter,
+		validatorPubKeyConverter: validatorPubKeyConverter,
+		nodePrice:                nodePrice,
+		totalSupply:              totalSupply,
+		denomination:             core.DefaultDenomination,
+		report: &verifyReport{
+			Directory: outputDirectory,
+			Passed:    true,
+		},
+	}
+}
+
+func TestOutputVerifier_EmptyDirectoryShouldFail(t *testing.T) {
+	t.Parallel()
+
+	report := createTestOutputVerifier(t, t.TempDir()).verify()
+	assert.False(t, report.Passed)
+
+	statuses := make(map[string]string)
+	for _, result := range report.Checks {
+		statuses[result.Name] = result.Status
+	}
+	assert.Equal(t, checkFailed, statuses["validator keys"])
+	assert.Equal(t, checkFailed, statuses["genesis accounts"])
+	assert.Equal(t, checkFailed, statuses["nodes setup"])
+	assert.Equal(t, checkSkipped, statuses["delegator keys"])
+	assert.Equal(t, checkSkipped, statuses["txgen accounts"])
+	assert.Equal(t, checkSkipped, statuses["wallet mnemonic"])
+	assert.Equal(t, checkSkipped, statuses["genesis smart contracts"])
+}
+
+func TestOutputVerifier_MissingDirectoryShouldFail(t *testing.T) {
+	t.Parallel()
+
+	report := createTestOutputVerifier(t, filepath.Join(t.TempDir(), "does-not-exist")).verify()
+	assert.False(t, report.Passed)
+}
+
+func writeTestWalletKeystores(t *testing.T, outputDirectory string, passphrase string, numKeys int) []string {
+	_, walletPubKeyConverter, err := filegen.CreatePubKeyConverters()
+	require.Nil(t, err)
+
+	kh, err := core.NewKeystoreHandler(filepath.Join(outputDirectory, "walletKeys"), passphrase, rand.Reader)
+	require.Nil(t, err)
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	addresses := make([]string, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, errToBytes := sk.ToByteArray()
+		require.Nil(t, errToBytes)
+		pkBytes, errToBytes := pk.ToByteArray()
+		require.Nil(t, errToBytes)
+
+		address, errEncode := walletPubKeyConverter.Encode(pkBytes)
+		require.Nil(t, errEncode)
+		require.Nil(t, kh.SaveSkToKeystoreFile(address, pkBytes, skBytes))
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
+func TestOutputVerifier_CheckWalletKeysFromKeystores(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing passphrase should fail", func(t *testing.T) {
+		t.Parallel()
+
+		outputDirectory := t.TempDir()
+		writeTestWalletKeystores(t, outputDirectory, "passphrase", 2)
+
+		ov := createTestOutputVerifier(t, outputDirectory)
+		ov.keystorePassphraseErr = filegen.ErrMissingKeystorePassphrase
+		keys, err := ov.checkWalletKeys()
+		assert.Empty(t, keys)
+		assert.True(t, errors.Is(err, errVerificationFailed))
+		assert.Contains(t, err.Error(), filegen.ErrMissingKeystorePassphrase.Error())
+
+		ov.run("wallet keys", true, ov.checkWalletKeys)
+		assert.False(t, ov.report.Passed)
+		assert.Equal(t, checkFailed, ov.report.Checks[0].Status)
+	})
+	t.Run("wrong passphrase should fail", func(t *testing.T) {
+		t.Parallel()
+
+		outputDirectory := t.TempDir()
+		writeTestWalletKeystores(t, outputDirectory, "passphrase", 2)
+
+		ov := createTestOutputVerifier(t, outputDirectory)
+		ov.keystorePassphrase = "wrong passphrase"
+		_, err := ov.checkWalletKeys()
+		assert.True(t, errors.Is(err, core.ErrInvalidKeystorePassphrase))
+	})
+	t.Run("should decrypt the keystores", func(t *testing.T) {
+		t.Parallel()
+
+		outputDirectory := t.TempDir()
+		addresses := writeTestWalletKeystores(t, outputDirectory, "passphrase", 2)
+
+		ov := createTestOutputVerifier(t, outputDirectory)
+		ov.keystorePassphrase = "passphrase"
+		details, err := ov.checkWalletKeys()
+		require.Nil(t, err)
+		assert.Equal(t, "2 keys from the keystore files, each secret key derives its address", details)
+		require.Equal(t, 2, len(ov.walletKeys))
+		assert.NotEmpty(t, ov.walletKeys[0].PrivKeyBytes)
+
+		loadedAddresses := make([]string, 0, len(ov.walletKeys))
+		for _, key := range ov.walletKeys {
+			address, _ := ov.walletPubKeyConverter.Encode(key.PubKeyBytes)
+			loadedAddresses = append(loadedAddresses, address)
+		}
+		assert.ElementsMatch(t, addresses, loadedAddresses)
+	})
+}
+
+func TestOutputVerifier_CheckWalletKeysAccounts(t *testing.T) {
+	t.Parallel()
+
+	outputDirectory := t.TempDir()
+	addresses := writeTestWalletKeystores(t, outputDirectory, "passphrase", 2)
+	stakingValue := big.NewInt(2500)
+
+	t.Run("wallet key without genesis account should fail", func(t *testing.T) {
+		t.Parallel()
+
+		ov := createTestOutputVerifier(t, outputDirectory)
+		ov.keystorePassphrase = "passphrase"
+		_, err := ov.checkWalletKeys()
+		require.Nil(t, err)
+		ov.initialAccounts = []mxData.InitialAccount{{Address: addresses[0], StakingValue: stakingValue}}
+
+		err = ov.checkWalletKeysAccounts()
+		assert.True(t, errors.Is(err, errVerificationFailed))
+		assert.Contains(t, err.Error(), "has no genesis account")
+	})
+	t.Run("staking account without wallet key should fail", func(t *testing.T) {
+		t.Parallel()
+
+		ov := createTestOutputVerifier(t, outputDirectory)
+		ov.keystorePassphrase = "passphrase"
+		_, err := ov.checkWalletKeys()
+		require.Nil(t, err)
+		ov.walletKeys = ov.walletKeys[:1]
+		ov.initialAccounts = []mxData.InitialAccount{
+			{Address: addresses[0], StakingValue: stakingValue},
+			{Address: addresses[1], StakingValue: stakingValue},
+		}
+
+		err = ov.checkWalletKeysAccounts()
+		assert.True(t, errors.Is(err, errVerificationFailed))
+		assert.Contains(t, err.Error(), "has no wallet key")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ov := createTestOutputVerifier(t, outputDirectory)
+		ov.keystorePassphrase = "passphrase"
+		_, err := ov.checkWalletKeys()
+		require.Nil(t, err)
+		ov.initialAccounts = []mxData.InitialAccount{
+			{Address: addresses[0], StakingValue: stakingValue},
+			{Address: addresses[1], StakingValue: stakingValue},
+		}
+
+		assert.Nil(t, ov.checkWalletKeysAccounts())
+	})
+}
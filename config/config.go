@@ -0,0 +1,92 @@
+package config
+
+// ScenarioConfig holds the declarative description of the network that will be generated
+type ScenarioConfig struct {
+	Version   uint32
+	Output    OutputConfig
+	Network   NetworkConfig
+	Economics EconomicsConfig
+	Staking   StakingConfig
+	Keys      KeysConfig
+}
+
+// OutputConfig holds the settings related to the generated files
+type OutputConfig struct {
+	Directory              string
+	GenerateTxgenFile      bool
+	WalletKeyFormat        string
+	KeystorePassphraseFile string
+	PlaintextMnemonic      bool
+	Layout                 string
+	Writers                []string
+}
+
+// NetworkConfig holds the network topology settings
+type NetworkConfig struct {
+	NumOfShards                 int
+	NumOfNodesInEachShard       int
+	ConsensusGroupSize          int
+	NumOfObserversInEachShard   int
+	NumOfMetachainNodes         int
+	MetachainConsensusGroupSize int
+	NumOfObserversInMetachain   int
+	Hysteresis                  float64
+	Adaptivity                  bool
+	RoundDuration               uint64
+	InitialRating               uint64
+	RatingProfile               string
+	RatingOverrides             string
+}
+
+// EconomicsConfig holds the settings related to the genesis supply and its distribution
+type EconomicsConfig struct {
+	TotalSupply           string
+	NodePrice             string
+	Denomination          int
+	NumAdditionalAccounts int
+	RichestAccount        bool
+	MinOwnerBalance       string
+	MinDelegatorBalance   string
+	MinAdditionalBalance  string
+	RemainderSink         string
+	TreasuryAddress       string
+	TreasuryPercent       float64
+	AllocationsFile       string
+	SnapshotFile          string
+	SnapshotSupply        string
+}
+
+// StakingConfig holds the settings related to the way the initial nodes are staked
+type StakingConfig struct {
+	StakeType                string
+	DelegationOwnerPublicKey string
+	NumDelegators            uint
+	NumDelegatedNodes        uint
+	MaxNumValidatorsPerOwner uint
+	OwnerDistribution        string
+	TopUpPolicy              string
+	DelegatorDistribution    string
+	MinDelegation            string
+	DelegationServiceFee     uint64
+	DelegationCap            string
+	DelegationInit           string
+	DelegationVersion        string
+	DelegationProviders      []DelegationProviderConfig
+}
+
+// DelegationProviderConfig holds the settings of a staking provider that stakes its nodes through its own delegation
+// contract. A NumNodes value of 0 means that the provider holds all the delegated nodes not held by the other providers
+type DelegationProviderConfig struct {
+	OwnerPublicKey string
+	OwnerNonce     uint64
+	NumNodes       uint
+	NumDelegators  uint
+}
+
+// KeysConfig holds the settings related to the way the keys are generated
+type KeysConfig struct {
+	Seed              string
+	UseMnemonic       bool
+	MnemonicFile      string
+	ValidatorKeysFile string
+}
@@ -0,0 +1,13 @@
+package config
+
+import "errors"
+
+// ErrUnsupportedScenarioVersion signals that the scenario file declares a version this binary can not handle
+var ErrUnsupportedScenarioVersion = errors.New("unsupported scenario version")
+
+// ErrUnknownScenarioFileFormat signals that the scenario file extension is not recognized
+var ErrUnknownScenarioFileFormat = errors.New("unknown scenario file format")
+
+// ErrInvalidScenarioFile signals that the scenario file could not be decoded, either because it is malformed or
+// because it holds unknown fields
+var ErrInvalidScenarioFile = errors.New("invalid scenario file")
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentScenarioVersion is the scenario file version produced and understood by this binary
+const CurrentScenarioVersion = uint32(1)
+
+const tomlExtension = ".toml"
+const jsonExtension = ".json"
+const yamlExtension = ".yaml"
+const ymlExtension = ".yml"
+
+// LoadScenarioConfig will load the scenario file found at the provided path over the provided scenario config.
+// The fields that are missing from the file will keep the values already set in the scenario config, while the
+// unknown fields are rejected so a misspelled key will not silently generate a different network
+func LoadScenarioConfig(filePath string, scenario *ScenarioConfig) error {
+	// the version should always be explicitly declared in the scenario file
+	scenario.Version = 0
+
+	var err error
+	extension := strings.ToLower(filepath.Ext(filePath))
+	switch extension {
+	case tomlExtension:
+		err = loadTomlScenario(filePath, scenario)
+	case jsonExtension:
+		err = loadJsonScenario(filePath, scenario)
+	case yamlExtension, ymlExtension:
+		err = loadYamlScenario(filePath, scenario)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownScenarioFileFormat, extension)
+	}
+	if err != nil {
+		return fmt.Errorf("%w while loading scenario file %s", err, filePath)
+	}
+
+	if scenario.Version != CurrentScenarioVersion {
+		return fmt.Errorf("%w: file %s declares version %d, supported version is %d",
+			ErrUnsupportedScenarioVersion, filePath, scenario.Version, CurrentScenarioVersion)
+	}
+
+	return nil
+}
+
+func loadTomlScenario(filePath string, scenario *ScenarioConfig) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	err = toml.NewDecoder(f).Strict(true).Decode(scenario)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidScenarioFile, err.Error())
+	}
+
+	return nil
+}
+
+func loadJsonScenario(filePath string, scenario *ScenarioConfig) error {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	return decodeJsonScenario(buff, scenario)
+}
+
+// loadYamlScenario decodes the YAML document as a generic tree and feeds it to the JSON decoder, so the YAML keys
+// are the same field names used by the TOML and JSON scenario files
+func loadYamlScenario(filePath string, scenario *ScenarioConfig) error {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	document := make(map[string]interface{})
+	err = yaml.Unmarshal(buff, &document)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidScenarioFile, err.Error())
+	}
+
+	jsonBuff, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidScenarioFile, err.Error())
+	}
+
+	return decodeJsonScenario(jsonBuff, scenario)
+}
+
+func decodeJsonScenario(buff []byte, scenario *ScenarioConfig) error {
+	decoder := json.NewDecoder(bytes.NewReader(buff))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(scenario)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidScenarioFile, err.Error())
+	}
+
+	return nil
+}
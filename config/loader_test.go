@@ -0,0 +1,250 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDefaultScenario() *ScenarioConfig {
+	return &ScenarioConfig{
+		Version: CurrentScenarioVersion,
+		Output: OutputConfig{
+			Directory: "./output",
+		},
+		Network: NetworkConfig{
+			NumOfShards:           3,
+			NumOfNodesInEachShard: 7,
+			Hysteresis:            0.2,
+		},
+		Economics: EconomicsConfig{
+			TotalSupply: "20000000000000000000000000",
+			NodePrice:   "2500000000000000000000",
+		},
+		Staking: StakingConfig{
+			StakeType: "direct",
+		},
+	}
+}
+
+func writeScenarioFile(t *testing.T, fileName string, content string) string {
+	filePath := filepath.Join(t.TempDir(), fileName)
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestLoadScenarioConfig_TomlShouldOverrideOnlyProvidedFields(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.toml", `
+Version = 1
+
+[Network]
+    NumOfShards = 5
+    Hysteresis = 0.0
+
+[Staking]
+    StakeType = "delegated"
+    NumDelegators = 1293
+`)
+
+	scenario := createDefaultScenario()
+	err := LoadScenarioConfig(filePath, scenario)
+	require.Nil(t, err)
+
+	assert.Equal(t, 5, scenario.Network.NumOfShards)
+	assert.Equal(t, 0.0, scenario.Network.Hysteresis)
+	assert.Equal(t, 7, scenario.Network.NumOfNodesInEachShard)
+	assert.Equal(t, "delegated", scenario.Staking.StakeType)
+	assert.Equal(t, uint(1293), scenario.Staking.NumDelegators)
+	assert.Equal(t, "./output", scenario.Output.Directory)
+	assert.Equal(t, "2500000000000000000000", scenario.Economics.NodePrice)
+}
+
+func TestLoadScenarioConfig_TomlWithDelegationProvidersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.toml", `
+Version = 1
+
+[Staking]
+    StakeType = "delegated"
+
+    [[Staking.DelegationProviders]]
+        OwnerPublicKey = "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80"
+        NumNodes = 10
+        NumDelegators = 100
+
+    [[Staking.DelegationProviders]]
+        OwnerPublicKey = "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80"
+        OwnerNonce = 1
+        NumDelegators = 20
+`)
+
+	scenario := createDefaultScenario()
+	err := LoadScenarioConfig(filePath, scenario)
+	require.Nil(t, err)
+
+	expectedProviders := []DelegationProviderConfig{
+		{
+			OwnerPublicKey: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:     0,
+			NumNodes:       10,
+			NumDelegators:  100,
+		},
+		{
+			OwnerPublicKey: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:     1,
+			NumNodes:       0,
+			NumDelegators:  20,
+		},
+	}
+	assert.Equal(t, expectedProviders, scenario.Staking.DelegationProviders)
+}
+
+func TestLoadScenarioConfig_JsonShouldWork(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.json", `{
+  "Version": 1,
+  "Economics": {
+    "TotalSupply": "1000000"
+  }
+}`)
+
+	scenario := createDefaultScenario()
+	err := LoadScenarioConfig(filePath, scenario)
+	require.Nil(t, err)
+
+	assert.Equal(t, "1000000", scenario.Economics.TotalSupply)
+	assert.Equal(t, 3, scenario.Network.NumOfShards)
+}
+
+func TestLoadScenarioConfig_YamlShouldWork(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.yaml", `
+Version: 1
+Network:
+  NumOfShards: 5
+  Hysteresis: 0.0
+Staking:
+  StakeType: delegated
+  DelegationProviders:
+    - OwnerPublicKey: erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80
+      NumNodes: 10
+      NumDelegators: 100
+`)
+
+	scenario := createDefaultScenario()
+	err := LoadScenarioConfig(filePath, scenario)
+	require.Nil(t, err)
+
+	assert.Equal(t, 5, scenario.Network.NumOfShards)
+	assert.Equal(t, 0.0, scenario.Network.Hysteresis)
+	assert.Equal(t, 7, scenario.Network.NumOfNodesInEachShard)
+	assert.Equal(t, "delegated", scenario.Staking.StakeType)
+	assert.Equal(t, "2500000000000000000000", scenario.Economics.NodePrice)
+
+	expectedProviders := []DelegationProviderConfig{
+		{
+			OwnerPublicKey: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			NumNodes:       10,
+			NumDelegators:  100,
+		},
+	}
+	assert.Equal(t, expectedProviders, scenario.Staking.DelegationProviders)
+}
+
+func TestLoadScenarioConfig_YmlExtensionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.yml", "Version: 1\n")
+
+	err := LoadScenarioConfig(filePath, createDefaultScenario())
+	assert.Nil(t, err)
+}
+
+func TestLoadScenarioConfig_UnknownFieldShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("toml", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := writeScenarioFile(t, "scenario.toml", `
+Version = 1
+
+[Network]
+    NumOfShard = 5
+`)
+
+		scenario := createDefaultScenario()
+		err := LoadScenarioConfig(filePath, scenario)
+		require.True(t, errors.Is(err, ErrInvalidScenarioFile))
+		assert.Contains(t, err.Error(), "NumOfShard")
+		assert.Equal(t, 3, scenario.Network.NumOfShards)
+	})
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := writeScenarioFile(t, "scenario.json", `{
+  "Version": 1,
+  "Economics": {
+    "TotalSuply": "1000000"
+  }
+}`)
+
+		err := LoadScenarioConfig(filePath, createDefaultScenario())
+		require.True(t, errors.Is(err, ErrInvalidScenarioFile))
+		assert.Contains(t, err.Error(), "TotalSuply")
+	})
+	t.Run("yaml", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := writeScenarioFile(t, "scenario.yaml", `
+Version: 1
+Staking:
+  StakeTpe: delegated
+`)
+
+		err := LoadScenarioConfig(filePath, createDefaultScenario())
+		require.True(t, errors.Is(err, ErrInvalidScenarioFile))
+		assert.Contains(t, err.Error(), "StakeTpe")
+	})
+}
+
+func TestLoadScenarioConfig_MalformedYamlShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.yaml", "Version: [1\n")
+
+	err := LoadScenarioConfig(filePath, createDefaultScenario())
+	assert.True(t, errors.Is(err, ErrInvalidScenarioFile))
+}
+
+func TestLoadScenarioConfig_MissingVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.toml", `
+[Network]
+    NumOfShards = 5
+`)
+
+	err := LoadScenarioConfig(filePath, createDefaultScenario())
+	assert.True(t, errors.Is(err, ErrUnsupportedScenarioVersion))
+}
+
+func TestLoadScenarioConfig_UnknownFormatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeScenarioFile(t, "scenario.ini", "")
+
+	err := LoadScenarioConfig(filePath, createDefaultScenario())
+	assert.True(t, errors.Is(err, ErrUnknownScenarioFileFormat))
+}
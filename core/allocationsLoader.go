@@ -0,0 +1,139 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+)
+
+// Allocation represents a fixed genesis allocation, as found in the allocations file
+type Allocation struct {
+	Address      string                `json:"address"`
+	Balance      string                `json:"balance"`
+	StakingValue string                `json:"stakingValue"`
+	Delegation   *AllocationDelegation `json:"delegation"`
+}
+
+// AllocationDelegation represents the optional delegation of a fixed genesis allocation
+type AllocationDelegation struct {
+	Address string `json:"address"`
+	Value   string `json:"value"`
+}
+
+// LoadAllocations will load the fixed genesis allocations from the provided JSON file and will convert them in
+// initial accounts. The amounts are converted using the provided denomination. The optional delegation value
+// defaults to 0, while a staking value is rejected as the allocations do not own any node
+func LoadAllocations(
+	filePath string,
+	denomination int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) ([]mxData.InitialAccount, error) {
+	if check.IfNil(walletPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	allocations := make([]*Allocation, 0)
+	err := mxCore.LoadJsonFile(&allocations, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(allocations) == 0 {
+		return nil, ErrEmptyAllocations
+	}
+
+	initialAccounts := make([]mxData.InitialAccount, 0, len(allocations))
+	addresses := make(map[string]struct{})
+	for i, allocation := range allocations {
+		account, errConvert := convertAllocation(allocation, denomination, walletPubKeyConverter)
+		if errConvert != nil {
+			return nil, fmt.Errorf("%w for the allocation at index %d", errConvert, i)
+		}
+
+		_, found := addresses[account.Address]
+		if found {
+			return nil, fmt.Errorf("%w %s for the allocation at index %d", ErrDuplicatedAddress, account.Address, i)
+		}
+		addresses[account.Address] = struct{}{}
+
+		initialAccounts = append(initialAccounts, account)
+	}
+
+	return initialAccounts, nil
+}
+
+func convertAllocation(
+	allocation *Allocation,
+	denomination int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) (mxData.InitialAccount, error) {
+	if allocation == nil {
+		return mxData.InitialAccount{}, ErrNilAllocation
+	}
+
+	_, err := walletPubKeyConverter.Decode(allocation.Address)
+	if err != nil {
+		return mxData.InitialAccount{}, fmt.Errorf("%w for address %s", err, allocation.Address)
+	}
+
+	balance, err := convertOptionalValue(allocation.Balance, denomination)
+	if err != nil {
+		return mxData.InitialAccount{}, fmt.Errorf("%w for the balance of %s", err, allocation.Address)
+	}
+	stakingValue, err := convertOptionalValue(allocation.StakingValue, denomination)
+	if err != nil {
+		return mxData.InitialAccount{}, fmt.Errorf("%w for the staking value of %s", err, allocation.Address)
+	}
+	if stakingValue.Sign() != 0 {
+		return mxData.InitialAccount{}, fmt.Errorf("%w: %s for %s, the allocations do not own any node, so their "+
+			"stake could not be backed by nodes", ErrStakingAllocation, allocation.StakingValue, allocation.Address)
+	}
+
+	delegation := &mxData.DelegationData{
+		Address: "",
+		Value:   big.NewInt(0),
+	}
+	if allocation.Delegation != nil {
+		delegation.Address = allocation.Delegation.Address
+		delegation.Value, err = convertOptionalValue(allocation.Delegation.Value, denomination)
+		if err != nil {
+			return mxData.InitialAccount{}, fmt.Errorf("%w for the delegation value of %s", err, allocation.Address)
+		}
+
+		_, err = walletPubKeyConverter.Decode(delegation.Address)
+		if err != nil {
+			return mxData.InitialAccount{}, fmt.Errorf("%w for the delegation address of %s", err, allocation.Address)
+		}
+	}
+
+	supply := big.NewInt(0).Add(balance, stakingValue)
+	supply.Add(supply, delegation.Value)
+
+	return mxData.InitialAccount{
+		Address:      allocation.Address,
+		Supply:       supply,
+		Balance:      balance,
+		StakingValue: stakingValue,
+		Delegation:   delegation,
+	}, nil
+}
+
+func convertOptionalValue(value string, denomination int) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	return ConvertAmount(value, denomination)
+}
+
+// ComputeTotalSupply returns the sum of the supplies of the provided initial accounts
+func ComputeTotalSupply(initialAccounts []mxData.InitialAccount) *big.Int {
+	totalSupply := big.NewInt(0)
+	for _, account := range initialAccounts {
+		totalSupply.Add(totalSupply, account.Supply)
+	}
+
+	return totalSupply
+}
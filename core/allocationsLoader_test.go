@@ -0,0 +1,141 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAllocationAddress1 = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testAllocationAddress2 = "erd1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq6mjse8"
+)
+
+func createAllocationsFile(t *testing.T, content string) string {
+	filePath := filepath.Join(t.TempDir(), "allocations.json")
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestLoadAllocations_NilConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts, err := LoadAllocations("allocations.json", DefaultDenomination, nil)
+	assert.Nil(t, accounts)
+	assert.Equal(t, ErrNilPubKeyConverter, err)
+}
+
+func TestLoadAllocations_EmptyFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	accounts, err := LoadAllocations(createAllocationsFile(t, "[]"), DefaultDenomination, converter)
+	assert.Nil(t, accounts)
+	assert.Equal(t, ErrEmptyAllocations, err)
+}
+
+func TestLoadAllocations_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	t.Run("invalid address", func(t *testing.T) {
+		t.Parallel()
+
+		content := `[{"address": "erd1invalid", "balance": "10"}]`
+		accounts, err := LoadAllocations(createAllocationsFile(t, content), DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.NotNil(t, err)
+	})
+	t.Run("negative balance", func(t *testing.T) {
+		t.Parallel()
+
+		content := `[{"address": "` + testAllocationAddress1 + `", "balance": "-10"}]`
+		accounts, err := LoadAllocations(createAllocationsFile(t, content), DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrNegativeValue))
+	})
+	t.Run("invalid delegation address", func(t *testing.T) {
+		t.Parallel()
+
+		content := `[{"address": "` + testAllocationAddress1 + `", "delegation": {"value": "10"}}]`
+		accounts, err := LoadAllocations(createAllocationsFile(t, content), DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.NotNil(t, err)
+	})
+	t.Run("staking value", func(t *testing.T) {
+		t.Parallel()
+
+		content := `[{"address": "` + testAllocationAddress1 + `", "balance": "10", "stakingValue": "2500 EGLD"}]`
+		accounts, err := LoadAllocations(createAllocationsFile(t, content), DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrStakingAllocation))
+		assert.Contains(t, err.Error(), testAllocationAddress1)
+	})
+	t.Run("duplicated address", func(t *testing.T) {
+		t.Parallel()
+
+		content := `[{"address": "` + testAllocationAddress1 + `", "balance": "10"}, ` +
+			`{"address": "` + testAllocationAddress1 + `", "balance": "20"}]`
+		accounts, err := LoadAllocations(createAllocationsFile(t, content), DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrDuplicatedAddress))
+	})
+}
+
+func TestLoadAllocations_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	content := `[
+	{"address": "` + testAllocationAddress1 + `", "balance": "1000", "stakingValue": "0"},
+	{"address": "` + testAllocationAddress2 + `", "balance": "10", 
+		"delegation": {"address": "` + testAllocationAddress1 + `", "value": "300"}}
+]`
+
+	accounts, err := LoadAllocations(createAllocationsFile(t, content), DefaultDenomination, converter)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(accounts))
+
+	assert.Equal(t, testAllocationAddress1, accounts[0].Address)
+	assert.Equal(t, big.NewInt(1000), accounts[0].Supply)
+	assert.Equal(t, big.NewInt(1000), accounts[0].Balance)
+	assert.Equal(t, big.NewInt(0), accounts[0].StakingValue)
+	assert.Equal(t, "", accounts[0].Delegation.Address)
+	assert.Equal(t, big.NewInt(0), accounts[0].Delegation.Value)
+
+	assert.Equal(t, testAllocationAddress2, accounts[1].Address)
+	assert.Equal(t, big.NewInt(310), accounts[1].Supply)
+	assert.Equal(t, big.NewInt(10), accounts[1].Balance)
+	assert.Equal(t, big.NewInt(0), accounts[1].StakingValue)
+	assert.Equal(t, testAllocationAddress1, accounts[1].Delegation.Address)
+	assert.Equal(t, big.NewInt(300), accounts[1].Delegation.Value)
+
+	assert.Equal(t, big.NewInt(1310), ComputeTotalSupply(accounts))
+}
+
+func TestLoadAllocations_DenominatedAmountsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	content := `[
+	{"address": "` + testAllocationAddress1 + `", "balance": "1.5K EGLD",
+		"delegation": {"address": "` + testAllocationAddress2 + `", "value": "2_500 EGLD"}}
+]`
+
+	accounts, err := LoadAllocations(createAllocationsFile(t, content), 3, converter)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(accounts))
+
+	assert.Equal(t, big.NewInt(1500000), accounts[0].Balance)
+	assert.Equal(t, big.NewInt(2500000), accounts[0].Delegation.Value)
+	assert.Equal(t, big.NewInt(4000000), accounts[0].Supply)
+}
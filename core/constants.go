@@ -8,3 +8,90 @@ const StakedType = "direct"
 
 // MixedType is the mixed staking type generation method that will generate both staked and delegated nodes
 const MixedType = "mixed"
+
+// KeystoreWalletKeyFormat is the wallet key output format that writes each key in an encrypted JSON keystore file
+const KeystoreWalletKeyFormat = "keystore"
+
+// PemWalletKeyFormat is the wallet key output format that writes all the keys as plaintext in a PEM file
+const PemWalletKeyFormat = "pem"
+
+// ClassicOutputLayout is the output layout that writes all the validator and observer keys in one PEM file
+const ClassicOutputLayout = "classic"
+
+// PerNodeOutputLayout is the output layout that writes the key of each node in its own node-<shard>-<index> directory
+const PerNodeOutputLayout = "per-node"
+
+// ClassicOutputWriter is the output writer producing the genesis, nodes setup, shard map and key files
+const ClassicOutputWriter = "classic"
+
+// AccountsDumpOutputWriter is the output writer dumping every genesis account as a JSON line
+const AccountsDumpOutputWriter = "accounts-jsonl"
+
+// EligibleRole is the role of a genesis validator that is part of the eligible list of its shard
+const EligibleRole = "eligible"
+
+// WaitingRole is the role of a genesis validator that is part of the waiting list of its shard
+const WaitingRole = "waiting"
+
+// ObserverRole is the role of a node that does not participate in consensus
+const ObserverRole = "observer"
+
+// FixedOwnerDistribution is the owner distribution in which every owner holds the same number of nodes
+const FixedOwnerDistribution = "fixed"
+
+// UniformOwnerDistribution is the owner distribution in which every owner holds a random number of nodes in [1, max]
+const UniformOwnerDistribution = "uniform"
+
+// ZipfOwnerDistribution is the power-law owner distribution: a few large providers and a long tail of solo stakers
+const ZipfOwnerDistribution = "zipf"
+
+// ListOwnerDistribution is the owner distribution in which the number of nodes of each owner is explicitly provided
+const ListOwnerDistribution = "list"
+
+// EqualDelegatorDistribution is the delegator distribution in which all delegators delegate the same value
+const EqualDelegatorDistribution = "equal"
+
+// RandomDelegatorDistribution is the delegator distribution in which each delegator delegates a random value in
+// the [minimum, maximum] interval
+const RandomDelegatorDistribution = "random"
+
+// ParetoDelegatorDistribution is the delegator distribution in which the delegated values follow a Pareto distribution
+const ParetoDelegatorDistribution = "pareto"
+
+// ListDelegatorDistribution is the delegator distribution in which the delegated values are loaded from a file
+const ListDelegatorDistribution = "list"
+
+// NoneTopUpPolicy is the top-up policy in which the owners stake exactly the price of their nodes
+const NoneTopUpPolicy = "none"
+
+// FixedTopUpPolicy is the top-up policy in which every owner stakes the same value on top of its nodes price
+const FixedTopUpPolicy = "fixed"
+
+// RandomTopUpPolicy is the top-up policy in which every owner stakes a random value in the [minimum, maximum]
+// interval on top of its nodes price
+const RandomTopUpPolicy = "random"
+
+// ProportionalTopUpPolicy is the top-up policy in which every owner stakes a percent of its nodes price on top of it
+const ProportionalTopUpPolicy = "proportional"
+
+// OwnerRemainderSink is the remainder sink in which the balance remainder goes to the first owner
+const OwnerRemainderSink = "owner"
+
+// AdditionalRemainderSink is the remainder sink in which the balance remainder goes to the first additional account
+const AdditionalRemainderSink = "additional"
+
+// TreasuryRemainderSink is the remainder sink in which the balance remainder goes to the treasury account
+const TreasuryRemainderSink = "treasury"
+
+// FixedRatingProfile is the rating profile in which every node starts with the same rating
+const FixedRatingProfile = "fixed"
+
+// RandomRatingProfile is the rating profile in which every node starts with a random rating in the [minimum, maximum]
+// interval
+const RandomRatingProfile = "random"
+
+// DefaultDenomination is the default number of decimals of the EGLD amounts
+const DefaultDenomination = 18
+
+// DenominationTicker is the unit marking a denominated amount, as in 20M EGLD
+const DenominationTicker = "EGLD"
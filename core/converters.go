@@ -2,7 +2,11 @@ package core
 
 import (
 	"encoding/hex"
+	"fmt"
 	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
 
 	mxCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/config"
@@ -18,19 +22,108 @@ import (
 	vmcommonBuiltInFunctions "github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
 )
 
-// ConvertToPositiveBigInt will try to convert the provided string to its big int corresponding value. Only
-// positive numbers are allowed
+// maxAmountExponent is the maximum absolute value of the decimal exponent accepted in an amount
+const maxAmountExponent = 100
+
+// amountRegex matches a decimal number with an optional exponent, capturing the exponent
+var amountRegex = regexp.MustCompile(`^[+-]?(?:\d+\.?\d*|\.\d+)(?:[eE]([+-]?\d+))?$`)
+
+var magnitudeSuffixes = map[string]int64{
+	"K": 3,
+	"M": 6,
+	"B": 9,
+	"T": 12,
+}
+
+// ConvertToPositiveBigInt will try to convert the provided string to its big int corresponding value, using the
+// default denomination. Only non-negative numbers are allowed, zero included
 func ConvertToPositiveBigInt(value string) (*big.Int, error) {
-	valueNumber, isNumber := big.NewInt(0).SetString(value, 10)
-	if !isNumber {
+	return ConvertAmount(value, DefaultDenomination)
+}
+
+// ConvertAmount will try to convert the provided amount to its value in the smallest denomination. Besides the raw
+// base 10 integers, the amount can contain underscores as digit separators, a decimal exponent (2.5e21), a magnitude
+// suffix (K, M, B or T) and the EGLD unit, in which case the value is multiplied by 10^denomination (20M EGLD,
+// 2500EGLD, 0.5 EGLD). Only non-negative amounts, representing a whole number of the smallest denomination, are allowed
+func ConvertAmount(value string, denomination int) (*big.Int, error) {
+	if denomination < 0 {
+		return nil, fmt.Errorf("%w for the denomination: %d", ErrInvalidValue, denomination)
+	}
+
+	amount := strings.ReplaceAll(strings.TrimSpace(value), "_", "")
+	isDenominated := false
+	if len(amount) >= len(DenominationTicker) &&
+		strings.EqualFold(amount[len(amount)-len(DenominationTicker):], DenominationTicker) {
+		amount = strings.TrimSpace(amount[:len(amount)-len(DenominationTicker)])
+		isDenominated = true
+	}
+
+	multiplier := big.NewInt(1)
+	if len(amount) > 0 {
+		magnitude, found := magnitudeSuffixes[amount[len(amount)-1:]]
+		if found {
+			amount = strings.TrimSpace(amount[:len(amount)-1])
+			multiplier.Exp(big.NewInt(10), big.NewInt(magnitude), nil)
+		}
+	}
+
+	var err error
+	matches := amountRegex.FindStringSubmatch(amount)
+	if matches == nil {
 		return nil, ErrStringIsNotANumber
 	}
+	exponent := 0
+	if len(matches[1]) > 0 {
+		exponent, err = strconv.Atoi(matches[1])
+	}
+	if err != nil || exponent > maxAmountExponent || exponent < -maxAmountExponent {
+		return nil, fmt.Errorf("%w, the exponent of %s is out of the [-%d, %d] interval",
+			ErrInvalidValue, value, maxAmountExponent, maxAmountExponent)
+	}
 
-	if valueNumber.Cmp(big.NewInt(0)) < 0 {
+	valueNumber, isNumber := big.NewRat(0, 1).SetString(amount)
+	if !isNumber {
+		return nil, ErrStringIsNotANumber
+	}
+	if valueNumber.Sign() < 0 {
 		return nil, ErrNegativeValue
 	}
 
-	return valueNumber, nil
+	if isDenominated {
+		multiplier.Mul(multiplier, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(denomination)), nil))
+	}
+	valueNumber.Mul(valueNumber, big.NewRat(0, 1).SetInt(multiplier))
+	if !valueNumber.IsInt() {
+		return nil, fmt.Errorf("%w, %s is not a whole number of the smallest denomination", ErrInvalidValue, value)
+	}
+
+	return big.NewInt(0).Set(valueNumber.Num()), nil
+}
+
+// FormatAmount returns the provided value in the smallest denomination together with its denominated value,
+// as in 2500 EGLD (2500000000000000000000)
+func FormatAmount(value *big.Int, denomination int) string {
+	if value == nil {
+		return "<nil>"
+	}
+	if denomination < 0 {
+		return value.String()
+	}
+
+	denominator := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(denomination)), nil)
+	integerPart, fractionalPart := big.NewInt(0).QuoRem(big.NewInt(0).Abs(value), denominator, big.NewInt(0))
+
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+	}
+	denominated := sign + integerPart.String()
+	if fractionalPart.Sign() > 0 {
+		fractionalString := fmt.Sprintf("%0*s", denomination, fractionalPart.String())
+		denominated += "." + strings.TrimRight(fractionalString, "0")
+	}
+
+	return fmt.Sprintf("%s %s (%s)", denominated, DenominationTicker, value.String())
 }
 
 // GenerateSCAddress will generate the resulting SC address from the provided public key string and nonce
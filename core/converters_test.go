@@ -34,6 +34,130 @@ func TestConvertToPositiveBigInt_ShouldWork(t *testing.T) {
 	assert.Equal(t, big.NewInt(1), val)
 }
 
+func TestConvertToPositiveBigInt_ZeroShouldWork(t *testing.T) {
+	t.Parallel()
+
+	val, err := ConvertToPositiveBigInt("0")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), val)
+
+	val, err = ConvertToPositiveBigInt("0 EGLD")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), val)
+}
+
+func TestConvertToPositiveBigInt_DenominatedAmountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	val, err := ConvertToPositiveBigInt("1 EGLD")
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000000000000000", val.String())
+}
+
+func TestConvertAmount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid amounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, value := range []string{"", "EGLD", "M", "abc", "1/3", "0x10", "1..2", "1e", "12 EGLDs", "1 M M"} {
+			val, err := ConvertAmount(value, DefaultDenomination)
+			assert.Nil(t, val, value)
+			assert.True(t, errors.Is(err, ErrStringIsNotANumber), value)
+		}
+	})
+	t.Run("negative amounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, value := range []string{"-1", "-2.5 EGLD", "-1e3"} {
+			val, err := ConvertAmount(value, DefaultDenomination)
+			assert.Nil(t, val, value)
+			assert.True(t, errors.Is(err, ErrNegativeValue), value)
+		}
+	})
+	t.Run("fractions of the smallest denomination should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, value := range []string{"1.5", "2.5e-1", "0.0000000000000000001 EGLD"} {
+			val, err := ConvertAmount(value, DefaultDenomination)
+			assert.Nil(t, val, value)
+			assert.True(t, errors.Is(err, ErrInvalidValue), value)
+		}
+	})
+	t.Run("out of range exponent should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, value := range []string{"1e101", "1e-101", "1e99999999999999999999"} {
+			val, err := ConvertAmount(value, DefaultDenomination)
+			assert.Nil(t, val, value)
+			assert.True(t, errors.Is(err, ErrInvalidValue), value)
+		}
+	})
+	t.Run("negative denomination should error", func(t *testing.T) {
+		t.Parallel()
+
+		val, err := ConvertAmount("1", -1)
+		assert.Nil(t, val)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := map[string]string{
+			"0":                                  "0",
+			"+5":                                 "5",
+			" 20000000000000000000000000 ":       "20000000000000000000000000",
+			"20_000_000_000_000_000_000_000_000": "20000000000000000000000000",
+			"20M EGLD":                           "20000000000000000000000000",
+			"20Megld":                            "20000000000000000000000000",
+			"2500EGLD":                           "2500000000000000000000",
+			"2_500 EGLD":                         "2500000000000000000000",
+			"2.5K EGLD":                          "2500000000000000000000",
+			"0.5 EGLD":                           "500000000000000000",
+			".5 EGLD":                            "500000000000000000",
+			"2.5e21":                             "2500000000000000000000",
+			"25E20":                              "2500000000000000000000",
+			"2.5e3 EGLD":                         "2500000000000000000000",
+			"1B":                                 "1000000000",
+			"1T EGLD":                            "1000000000000000000000000000000",
+			"1K":                                 "1000",
+		}
+		for value, expected := range testCases {
+			val, err := ConvertAmount(value, DefaultDenomination)
+			require.Nil(t, err, value)
+			assert.Equal(t, expected, val.String(), value)
+		}
+	})
+	t.Run("custom denomination should work", func(t *testing.T) {
+		t.Parallel()
+
+		val, err := ConvertAmount("2.5 EGLD", 6)
+		require.Nil(t, err)
+		assert.Equal(t, "2500000", val.String())
+
+		val, err = ConvertAmount("2500", 6)
+		require.Nil(t, err)
+		assert.Equal(t, "2500", val.String())
+
+		val, err = ConvertAmount("3 EGLD", 0)
+		require.Nil(t, err)
+		assert.Equal(t, "3", val.String())
+	})
+}
+
+func TestFormatAmount(t *testing.T) {
+	t.Parallel()
+
+	value, _ := big.NewInt(0).SetString("20000000000000000000000000", 10)
+	assert.Equal(t, "20000000 EGLD (20000000000000000000000000)", FormatAmount(value, DefaultDenomination))
+	assert.Equal(t, "0.5 EGLD (500000000000000000)", FormatAmount(big.NewInt(500000000000000000), DefaultDenomination))
+	assert.Equal(t, "0.000000000000000001 EGLD (1)", FormatAmount(big.NewInt(1), DefaultDenomination))
+	assert.Equal(t, "0 EGLD (0)", FormatAmount(big.NewInt(0), DefaultDenomination))
+	assert.Equal(t, "-2.5 EGLD (-2500)", FormatAmount(big.NewInt(-2500), 3))
+	assert.Equal(t, "2500 EGLD (2500)", FormatAmount(big.NewInt(2500), 0))
+	assert.Equal(t, "<nil>", FormatAmount(nil, DefaultDenomination))
+}
+
 func TestGenerateSCAddress_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// LoadDelegatedValues will load the delegated values from the provided file, one amount per line, using the provided
+// denomination. Empty lines and lines starting with # are ignored
+func LoadDelegatedValues(filePath string, denomination int) ([]*big.Int, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	values := make([]*big.Int, 0)
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, commentMarker) {
+			continue
+		}
+
+		value, errConvert := ConvertAmount(line, denomination)
+		if errConvert != nil {
+			return nil, fmt.Errorf("%w on line %d", errConvert, lineNumber)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, scanner.Err()
+}
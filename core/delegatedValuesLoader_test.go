@@ -0,0 +1,47 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDelegatedValuesFile(t *testing.T, content string) string {
+	filePath := filepath.Join(t.TempDir(), "delegatedValues.txt")
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestLoadDelegatedValues_MissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	values, err := LoadDelegatedValues(filepath.Join(t.TempDir(), "missing.txt"), DefaultDenomination)
+	assert.Nil(t, values)
+	assert.NotNil(t, err)
+}
+
+func TestLoadDelegatedValues_InvalidValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	values, err := LoadDelegatedValues(createDelegatedValuesFile(t, "10\n\n-5\n"), DefaultDenomination)
+	assert.Nil(t, values)
+	assert.True(t, errors.Is(err, ErrNegativeValue))
+	assert.True(t, strings.Contains(err.Error(), "line 3"))
+}
+
+func TestLoadDelegatedValues_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	content := "# whales\n 1000000 \n500000\n\n# dust\n1\n"
+	values, err := LoadDelegatedValues(createDelegatedValuesFile(t, content), DefaultDenomination)
+	require.Nil(t, err)
+	assert.Equal(t, []*big.Int{big.NewInt(1000000), big.NewInt(500000), big.NewInt(1)}, values)
+}
@@ -7,3 +7,66 @@ var ErrStringIsNotANumber = errors.New("string is not a number")
 
 // ErrNegativeValue signals that the provided value is negative
 var ErrNegativeValue = errors.New("negative value")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilPubKeyConverter signals that a nil pub key converter was provided
+var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrPublicKeyMismatch signals that the public key derived from a secret key does not match the advertised one
+var ErrPublicKeyMismatch = errors.New("public key mismatch")
+
+// ErrEmptyPassphrase signals that an empty passphrase was provided
+var ErrEmptyPassphrase = errors.New("empty passphrase")
+
+// ErrNilKeystore signals that a nil keystore was provided
+var ErrNilKeystore = errors.New("nil keystore")
+
+// ErrEmptyMnemonic signals that an empty mnemonic was provided
+var ErrEmptyMnemonic = errors.New("empty mnemonic")
+
+// ErrUnsupportedKeystore signals that the keystore uses an encryption scheme that is not supported
+var ErrUnsupportedKeystore = errors.New("unsupported keystore")
+
+// ErrInvalidKeystorePassphrase signals that the keystore could not be decrypted with the provided passphrase
+var ErrInvalidKeystorePassphrase = errors.New("invalid keystore passphrase")
+
+// ErrNilRandomReader signals that a nil random reader was provided
+var ErrNilRandomReader = errors.New("nil random reader")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNotEnoughNodes signals that the number of genesis nodes is smaller than the minimum required
+var ErrNotEnoughNodes = errors.New("not enough nodes")
+
+// ErrInvalidNumberOfObservers signals that the number of observers does not match the network configuration
+var ErrInvalidNumberOfObservers = errors.New("invalid number of observers")
+
+// ErrEmptyAllocations signals that the allocations file does not contain any allocation
+var ErrEmptyAllocations = errors.New("empty allocations")
+
+// ErrNilAllocation signals that a nil allocation was provided
+var ErrNilAllocation = errors.New("nil allocation")
+
+// ErrStakingAllocation signals that an allocation declares a staking value, while the allocations do not own any node
+var ErrStakingAllocation = errors.New("staking allocation")
+
+// ErrDuplicatedAddress signals that the same address was provided more than once
+var ErrDuplicatedAddress = errors.New("duplicated address")
+
+// ErrEmptySnapshot signals that the balances snapshot does not contain any account
+var ErrEmptySnapshot = errors.New("empty snapshot")
+
+// ErrUnknownSnapshotFormat signals that the format of the balances snapshot file is not known
+var ErrUnknownSnapshotFormat = errors.New("unknown snapshot format")
+
+// ErrMissingSnapshotColumn signals that a mandatory column is missing from the balances snapshot file
+var ErrMissingSnapshotColumn = errors.New("missing snapshot column")
+
+// ErrNilSnapshotAccount signals that a nil snapshot account was provided
+var ErrNilSnapshotAccount = errors.New("nil snapshot account")
+
+// ErrMissingKeystoreFile signals that an expected keystore file was not found
+var ErrMissingKeystoreFile = errors.New("missing keystore file")
@@ -16,15 +16,28 @@ type fileHandler struct {
 	*os.File
 }
 
+const publicFileMode = 0644
+const secretFileMode = 0600
+
 // NewFileHandler will try to open a new file in the provided output directory with the provided filename
 func NewFileHandler(outputDirectory string, fileName string) (*fileHandler, error) {
+	return newFileHandler(outputDirectory, fileName, publicFileMode)
+}
+
+// NewSecretFileHandler will try to open a new file, readable only by its owner, in the provided output directory
+// with the provided filename. Should be used for the files containing secret keys
+func NewSecretFileHandler(outputDirectory string, fileName string) (*fileHandler, error) {
+	return newFileHandler(outputDirectory, fileName, secretFileMode)
+}
+
+func newFileHandler(outputDirectory string, fileName string, mode os.FileMode) (*fileHandler, error) {
 	filePath := filepath.Join(outputDirectory, fileName)
 	err := os.Remove(filePath)
 	if err != nil && !os.IsNotExist(err) {
 		return nil, err
 	}
 
-	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY, 0666)
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY, mode)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +47,15 @@ func NewFileHandler(outputDirectory string, fileName string) (*fileHandler, erro
 	}, nil
 }
 
+func writeSecretFile(filePath string, buff []byte) error {
+	err := os.Remove(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return os.WriteFile(filePath, buff, secretFileMode)
+}
+
 // WriteObjectInFile will try to write the provided object in the file after it has been marshaled
 // in json format
 func (fh *fileHandler) WriteObjectInFile(data interface{}) error {
@@ -47,6 +69,19 @@ func (fh *fileHandler) WriteObjectInFile(data interface{}) error {
 	return err
 }
 
+// WriteObjectLineInFile will try to write the provided object in the file as a single line of compact json, so the
+// file can be consumed as JSON lines
+func (fh *fileHandler) WriteObjectLineInFile(data interface{}) error {
+	buff, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fh.Write(append(buff, '\n'))
+
+	return err
+}
+
 // SaveSkToPemFile saves secret key bytes in the file
 func (fh *fileHandler) SaveSkToPemFile(identifier string, skBytes []byte) error {
 	blk := pem.Block{
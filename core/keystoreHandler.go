@@ -0,0 +1,330 @@
+package core
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keystoreVersion   = 4
+	keystoreKind      = "secretKey"
+	mnemonicKind      = "mnemonic"
+	keystoreCipher    = "aes-128-ctr"
+	keystoreKDF       = "scrypt"
+	keystoreExtension = ".json"
+	scryptN           = 4096
+	scryptR           = 8
+	scryptP           = 1
+	scryptDKLen       = 32
+	saltLength        = 32
+)
+
+// EncryptedKeyJSON is the JSON keystore format accepted by the MultiversX wallets
+type EncryptedKeyJSON struct {
+	Version int        `json:"version"`
+	Kind    string     `json:"kind"`
+	Id      string     `json:"id"`
+	Address string     `json:"address,omitempty"`
+	Bech32  string     `json:"bech32,omitempty"`
+	Crypto  CryptoJSON `json:"crypto"`
+}
+
+// CryptoJSON holds the encryption details of a keystore
+type CryptoJSON struct {
+	Ciphertext   string           `json:"ciphertext"`
+	CipherParams CipherParamsJSON `json:"cipherparams"`
+	Cipher       string           `json:"cipher"`
+	KDF          string           `json:"kdf"`
+	KDFParams    KDFParamsJSON    `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+// CipherParamsJSON holds the cipher parameters of a keystore
+type CipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// KDFParamsJSON holds the scrypt parameters of a keystore
+type KDFParamsJSON struct {
+	DkLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+}
+
+type keystoreHandler struct {
+	outputDirectory string
+	passphrase      []byte
+	randReader      io.Reader
+	currentFiles    map[string]struct{}
+}
+
+// NewKeystoreHandler will create a handler able to write each secret key as an encrypted JSON keystore file in the
+// provided output directory. The salt, the IV and the id of each keystore are read from the provided random reader,
+// so a deterministic reader will produce identical keystore files
+func NewKeystoreHandler(outputDirectory string, passphrase string, randReader io.Reader) (*keystoreHandler, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+	if randReader == nil {
+		return nil, ErrNilRandomReader
+	}
+
+	err := os.MkdirAll(outputDirectory, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keystoreHandler{
+		outputDirectory: outputDirectory,
+		passphrase:      []byte(passphrase),
+		randReader:      randReader,
+		currentFiles:    make(map[string]struct{}),
+	}, nil
+}
+
+// SaveSkToKeystoreFile encrypts the secret key and saves it in the <identifier>.json file
+func (kh *keystoreHandler) SaveSkToKeystoreFile(identifier string, pkBytes []byte, skBytes []byte) error {
+	keystore, err := kh.encrypt(identifier, pkBytes, skBytes)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(keystore, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	filePath := filepath.Join(kh.outputDirectory, identifier+keystoreExtension)
+	err = writeSecretFile(filePath, buff)
+	if err != nil {
+		return err
+	}
+	kh.currentFiles[filePath] = struct{}{}
+
+	return nil
+}
+
+// KeepKeystoreFile marks the existing <identifier>.json file as part of the current output, so it will not be removed
+// as a stale keystore. Should be used for the keys whose secret keys are not known, such as the keys loaded back from
+// the keystore files when extending a network
+func (kh *keystoreHandler) KeepKeystoreFile(identifier string) error {
+	filePath := filepath.Join(kh.outputDirectory, identifier+keystoreExtension)
+	_, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("%w for %s: %s", ErrMissingKeystoreFile, identifier, err.Error())
+	}
+	kh.currentFiles[filePath] = struct{}{}
+
+	return nil
+}
+
+// RemoveStaleKeystoreFiles removes the keystore files of the output directory that were neither saved nor kept by
+// this handler, such as the keystores left by a previous run in the same directory. Should be called after all the
+// keys were handled, the same way the file handler starts its file from scratch
+func (kh *keystoreHandler) RemoveStaleKeystoreFiles() error {
+	filePaths, err := filepath.Glob(filepath.Join(kh.outputDirectory, "*"+keystoreExtension))
+	if err != nil {
+		return err
+	}
+
+	for _, filePath := range filePaths {
+		_, isCurrent := kh.currentFiles[filePath]
+		if isCurrent {
+			continue
+		}
+
+		err = os.Remove(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EncryptMnemonic encrypts the provided mnemonic as a keystore of the mnemonic kind, the same way the MultiversX
+// wallets store their secret phrase, so it can be saved next to the secret keys keystores
+func (kh *keystoreHandler) EncryptMnemonic(mnemonic string) (*EncryptedKeyJSON, error) {
+	if len(mnemonic) == 0 {
+		return nil, ErrEmptyMnemonic
+	}
+
+	keystore, err := kh.encrypt("", nil, []byte(mnemonic))
+	if err != nil {
+		return nil, err
+	}
+	keystore.Kind = mnemonicKind
+
+	return keystore, nil
+}
+
+// DecryptMnemonicKeystore will decrypt the mnemonic stored in the provided keystore of the mnemonic kind
+func DecryptMnemonicKeystore(keystore *EncryptedKeyJSON, passphrase string) (string, error) {
+	if keystore == nil {
+		return "", ErrNilKeystore
+	}
+	if keystore.Kind != mnemonicKind {
+		return "", fmt.Errorf("%w, kind %s instead of %s", ErrUnsupportedKeystore, keystore.Kind, mnemonicKind)
+	}
+
+	buff, err := DecryptKeystore(keystore, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buff), nil
+}
+
+func (kh *keystoreHandler) encrypt(identifier string, pkBytes []byte, skBytes []byte) (*EncryptedKeyJSON, error) {
+	salt, err := kh.readRandomBytes(saltLength)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := kh.readRandomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	id, err := kh.newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key(kh.passphrase, salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+
+	aesBlock, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := make([]byte, len(skBytes))
+	cipher.NewCTR(aesBlock, iv).XORKeyStream(ciphertext, skBytes)
+
+	hash := hmac.New(sha256.New, derivedKey[16:32])
+	_, _ = hash.Write(ciphertext)
+
+	return &EncryptedKeyJSON{
+		Version: keystoreVersion,
+		Kind:    keystoreKind,
+		Id:      id,
+		Address: hex.EncodeToString(pkBytes),
+		Bech32:  identifier,
+		Crypto: CryptoJSON{
+			Ciphertext: hex.EncodeToString(ciphertext),
+			CipherParams: CipherParamsJSON{
+				IV: hex.EncodeToString(iv),
+			},
+			Cipher: keystoreCipher,
+			KDF:    keystoreKDF,
+			KDFParams: KDFParamsJSON{
+				DkLen: scryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+				N:     scryptN,
+				R:     scryptR,
+				P:     scryptP,
+			},
+			MAC: hex.EncodeToString(hash.Sum(nil)),
+		},
+	}, nil
+}
+
+// DecryptKeystore will decrypt the secret key stored in the provided keystore, following the steps done by the
+// MultiversX wallets. A wrong passphrase is reported through the MAC mismatch
+func DecryptKeystore(keystore *EncryptedKeyJSON, passphrase string) ([]byte, error) {
+	if keystore == nil {
+		return nil, ErrNilKeystore
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+	if keystore.Crypto.Cipher != keystoreCipher || keystore.Crypto.KDF != keystoreKDF {
+		return nil, fmt.Errorf("%w, cipher %s and kdf %s", ErrUnsupportedKeystore, keystore.Crypto.Cipher,
+			keystore.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(keystore.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the salt", err)
+	}
+	iv, err := hex.DecodeString(keystore.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the iv", err)
+	}
+	ciphertext, err := hex.DecodeString(keystore.Crypto.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the ciphertext", err)
+	}
+	mac, err := hex.DecodeString(keystore.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the mac", err)
+	}
+
+	params := keystore.Crypto.KDFParams
+	if params.DkLen != scryptDKLen {
+		return nil, fmt.Errorf("%w, derived key length %d", ErrUnsupportedKeystore, params.DkLen)
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DkLen)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hmac.New(sha256.New, derivedKey[16:32])
+	_, _ = hash.Write(ciphertext)
+	if !hmac.Equal(mac, hash.Sum(nil)) {
+		return nil, ErrInvalidKeystorePassphrase
+	}
+
+	aesBlock, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aesBlock.BlockSize() {
+		return nil, fmt.Errorf("%w, iv length %d", ErrUnsupportedKeystore, len(iv))
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCTR(aesBlock, iv).XORKeyStream(plaintext, ciphertext)
+
+	return plaintext, nil
+}
+
+func (kh *keystoreHandler) readRandomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := io.ReadFull(kh.randReader, buff)
+
+	return buff, err
+}
+
+// newUUID returns a random (version 4) UUID
+func (kh *keystoreHandler) newUUID() (string, error) {
+	buff, err := kh.readRandomBytes(16)
+	if err != nil {
+		return "", err
+	}
+
+	buff[6] = (buff[6] & 0x0f) | 0x40
+	buff[8] = (buff[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buff[0:4], buff[4:6], buff[6:8], buff[8:10], buff[10:16]), nil
+}
+
+// Close does nothing as each keystore file is closed right after it was written
+func (kh *keystoreHandler) Close() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kh *keystoreHandler) IsInterfaceNil() bool {
+	return kh == nil
+}
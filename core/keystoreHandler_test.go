@@ -0,0 +1,200 @@
+package core
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeystoreHandler_EmptyPassphraseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	kh, err := NewKeystoreHandler(t.TempDir(), "", rand.Reader)
+	assert.Nil(t, kh)
+	assert.Equal(t, ErrEmptyPassphrase, err)
+}
+
+func TestNewKeystoreHandler_NilRandomReaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	kh, err := NewKeystoreHandler(t.TempDir(), "passphrase", nil)
+	assert.Nil(t, kh)
+	assert.Equal(t, ErrNilRandomReader, err)
+}
+
+func TestKeystoreHandler_RemoveStaleKeystoreFilesShouldKeepTheSavedAndKeptFiles(t *testing.T) {
+	t.Parallel()
+
+	outputDirectory := t.TempDir()
+	staleKeystorePath := filepath.Join(outputDirectory, "stale"+keystoreExtension)
+	keptKeystorePath := filepath.Join(outputDirectory, "kept"+keystoreExtension)
+	otherFilePath := filepath.Join(outputDirectory, "notes.txt")
+	require.Nil(t, os.WriteFile(staleKeystorePath, []byte("{}"), 0600))
+	require.Nil(t, os.WriteFile(keptKeystorePath, []byte("{}"), 0600))
+	require.Nil(t, os.WriteFile(otherFilePath, []byte("notes"), 0600))
+
+	kh, err := NewKeystoreHandler(outputDirectory, "passphrase", rand.Reader)
+	require.Nil(t, err)
+	assert.FileExists(t, staleKeystorePath)
+
+	err = kh.SaveSkToKeystoreFile("saved", bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32))
+	require.Nil(t, err)
+	err = kh.KeepKeystoreFile("kept")
+	require.Nil(t, err)
+	err = kh.KeepKeystoreFile("missing")
+	assert.True(t, errors.Is(err, ErrMissingKeystoreFile))
+
+	err = kh.RemoveStaleKeystoreFiles()
+	require.Nil(t, err)
+
+	assert.NoFileExists(t, staleKeystorePath)
+	assert.FileExists(t, keptKeystorePath)
+	assert.FileExists(t, filepath.Join(outputDirectory, "saved"+keystoreExtension))
+	assert.FileExists(t, otherFilePath)
+}
+
+func TestKeystoreHandler_SameRandomBytesShouldProduceIdenticalFiles(t *testing.T) {
+	t.Parallel()
+
+	sk, pk := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+
+	saveKeystore := func() []byte {
+		outputDirectory := t.TempDir()
+		kh, err := NewKeystoreHandler(outputDirectory, "passphrase", bytes.NewReader(bytes.Repeat([]byte{7}, 1024)))
+		require.Nil(t, err)
+		require.Nil(t, kh.SaveSkToKeystoreFile("key", pkBytes, skBytes))
+
+		buff, err := os.ReadFile(filepath.Join(outputDirectory, "key.json"))
+		require.Nil(t, err)
+
+		return buff
+	}
+
+	assert.Equal(t, saveKeystore(), saveKeystore())
+}
+
+func TestKeystoreHandler_SaveSkToKeystoreFileShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	sk, pk := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+	address, _ := converter.Encode(pkBytes)
+
+	outputDirectory := filepath.Join(t.TempDir(), "walletKeys")
+	kh, err := NewKeystoreHandler(outputDirectory, "passphrase", rand.Reader)
+	require.Nil(t, err)
+
+	err = kh.SaveSkToKeystoreFile(address, pkBytes, skBytes)
+	require.Nil(t, err)
+
+	filePath := filepath.Join(outputDirectory, address+".json")
+	info, err := os.Stat(filePath)
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	buff, _ := os.ReadFile(filePath)
+	keystore := &EncryptedKeyJSON{}
+	require.Nil(t, json.Unmarshal(buff, keystore))
+	assert.Equal(t, 4, keystore.Version)
+	assert.Equal(t, "secretKey", keystore.Kind)
+	assert.Equal(t, address, keystore.Bech32)
+	assert.Equal(t, hex.EncodeToString(pkBytes), keystore.Address)
+	assert.Equal(t, 36, len(keystore.Id))
+
+	recovered, err := DecryptKeystore(keystore, "wrong passphrase")
+	assert.Nil(t, recovered)
+	assert.Equal(t, ErrInvalidKeystorePassphrase, err)
+
+	recovered, err = DecryptKeystore(keystore, "passphrase")
+	assert.Nil(t, err)
+	assert.Equal(t, skBytes, recovered)
+}
+
+func TestDecryptKeystore_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	recovered, err := DecryptKeystore(nil, "passphrase")
+	assert.Nil(t, recovered)
+	assert.Equal(t, ErrNilKeystore, err)
+
+	recovered, err = DecryptKeystore(&EncryptedKeyJSON{}, "")
+	assert.Nil(t, recovered)
+	assert.Equal(t, ErrEmptyPassphrase, err)
+
+	recovered, err = DecryptKeystore(&EncryptedKeyJSON{}, "passphrase")
+	assert.Nil(t, recovered)
+	assert.True(t, errors.Is(err, ErrUnsupportedKeystore))
+}
+
+func TestKeystoreHandler_EncryptMnemonic(t *testing.T) {
+	t.Parallel()
+
+	mnemonic := "moral volcano peasant pass circle pen over picture flat shop clap goat"
+
+	t.Run("empty mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		kh, _ := NewKeystoreHandler(t.TempDir(), "passphrase", rand.Reader)
+		keystore, err := kh.EncryptMnemonic("")
+		assert.Nil(t, keystore)
+		assert.Equal(t, ErrEmptyMnemonic, err)
+	})
+	t.Run("secret key keystore should not be decrypted as a mnemonic", func(t *testing.T) {
+		t.Parallel()
+
+		kh, _ := NewKeystoreHandler(t.TempDir(), "passphrase", rand.Reader)
+		keystore, err := kh.encrypt("key", bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32))
+		require.Nil(t, err)
+
+		recovered, err := DecryptMnemonicKeystore(keystore, "passphrase")
+		assert.Empty(t, recovered)
+		assert.True(t, errors.Is(err, ErrUnsupportedKeystore))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		kh, _ := NewKeystoreHandler(t.TempDir(), "passphrase", rand.Reader)
+		keystore, err := kh.EncryptMnemonic(mnemonic)
+		require.Nil(t, err)
+		assert.Equal(t, mnemonicKind, keystore.Kind)
+		assert.Empty(t, keystore.Address)
+		assert.Empty(t, keystore.Bech32)
+		assert.NotContains(t, keystore.Crypto.Ciphertext, hex.EncodeToString([]byte(mnemonic)))
+
+		recovered, err := DecryptMnemonicKeystore(keystore, "wrong passphrase")
+		assert.Empty(t, recovered)
+		assert.Equal(t, ErrInvalidKeystorePassphrase, err)
+
+		recovered, err = DecryptMnemonicKeystore(keystore, "passphrase")
+		require.Nil(t, err)
+		assert.Equal(t, mnemonic, recovered)
+	})
+}
+
+func TestNewSecretFileHandler_ShouldCreateOwnerOnlyFile(t *testing.T) {
+	t.Parallel()
+
+	outputDirectory := t.TempDir()
+	fh, err := NewSecretFileHandler(outputDirectory, "walletKey.pem")
+	require.Nil(t, err)
+	fh.Close()
+
+	info, err := os.Stat(filepath.Join(outputDirectory, "walletKey.pem"))
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"fmt"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+// ArgNodesAssignment represents the argument used to compute the shard of each genesis node
+type ArgNodesAssignment struct {
+	InitialNodes            []*sharding.InitialNode
+	ObserverPubKeys         []string
+	NumOfShards             uint32
+	MinNodesPerShard        uint32
+	MetaChainMinNodes       uint32
+	Hysteresis              float32
+	NumOfObserversPerShard  uint32
+	NumOfMetachainObservers uint32
+}
+
+// NodeAssignment holds the destination shard and the role of a genesis node together with its index inside that shard
+type NodeAssignment struct {
+	PubKey  string
+	Address string
+	ShardID uint32
+	Role    string
+	Index   uint32
+}
+
+// ComputeNodesAssignment will compute the shard of each validator, the same way the node's sharding.NodesSetup
+// processes the initial nodes, and the shard of each observer, the observers being grouped by shard, in order, and the
+// metachain ones being the last. In each shard, the validators are indexed first, followed by the observers
+func ComputeNodesAssignment(arg ArgNodesAssignment) ([]*NodeAssignment, error) {
+	if arg.MinNodesPerShard == 0 {
+		return nil, fmt.Errorf("%w for MinNodesPerShard", ErrInvalidValue)
+	}
+	numNodes := uint32(len(arg.InitialNodes))
+	if numNodes < arg.MetaChainMinNodes+arg.MinNodesPerShard {
+		return nil, fmt.Errorf("%w, provided: %d, minimum: %d",
+			ErrNotEnoughNodes, numNodes, arg.MetaChainMinNodes+arg.MinNodesPerShard)
+	}
+	expectedNumObservers := arg.NumOfShards*arg.NumOfObserversPerShard + arg.NumOfMetachainObservers
+	if uint32(len(arg.ObserverPubKeys)) != expectedNumObservers {
+		return nil, fmt.Errorf("%w, provided: %d, expected: %d",
+			ErrInvalidNumberOfObservers, len(arg.ObserverPubKeys), expectedNumObservers)
+	}
+
+	validatorShards, eligible := computeValidatorShards(arg)
+	nextIndex := make(map[uint32]uint32)
+	assignments := make([]*NodeAssignment, 0, len(arg.InitialNodes)+len(arg.ObserverPubKeys))
+	for i, initialNode := range arg.InitialNodes {
+		shardID := validatorShards[i]
+		role := WaitingRole
+		if eligible[i] {
+			role = EligibleRole
+		}
+
+		assignments = append(assignments, &NodeAssignment{
+			PubKey:  initialNode.PubKey,
+			Address: initialNode.Address,
+			ShardID: shardID,
+			Role:    role,
+			Index:   nextIndex[shardID],
+		})
+		nextIndex[shardID]++
+	}
+
+	for i, pubKey := range arg.ObserverPubKeys {
+		shardID := mxCore.MetachainShardId
+		if uint32(i) < arg.NumOfShards*arg.NumOfObserversPerShard {
+			shardID = uint32(i) / arg.NumOfObserversPerShard
+		}
+
+		assignments = append(assignments, &NodeAssignment{
+			PubKey:  pubKey,
+			ShardID: shardID,
+			Role:    ObserverRole,
+			Index:   nextIndex[shardID],
+		})
+		nextIndex[shardID]++
+	}
+
+	return assignments, nil
+}
+
+// computeValidatorShards replicates sharding.NodesSetup: the first nodes are the metachain eligible ones, followed by
+// the eligible nodes of each shard, in FIFO order. The rest are distributed in a round-robin manner in the waiting lists
+func computeValidatorShards(arg ArgNodesAssignment) ([]uint32, []bool) {
+	numNodes := uint32(len(arg.InitialNodes))
+	shards := make([]uint32, numNodes)
+	eligible := make([]bool, numNodes)
+
+	for i := uint32(0); i < arg.MetaChainMinNodes; i++ {
+		shards[i] = mxCore.MetachainShardId
+		eligible[i] = true
+	}
+
+	hystMeta := uint32(float32(arg.MetaChainMinNodes) * arg.Hysteresis)
+	hystShard := uint32(float32(arg.MinNodesPerShard) * arg.Hysteresis)
+	numOfShards := (numNodes - arg.MetaChainMinNodes - hystMeta) / (arg.MinNodesPerShard + hystShard)
+	if numOfShards > arg.NumOfShards {
+		numOfShards = arg.NumOfShards
+	}
+
+	index := arg.MetaChainMinNodes
+	for shardID := uint32(0); shardID < numOfShards; shardID++ {
+		for ; index < arg.MetaChainMinNodes+(shardID+1)*arg.MinNodesPerShard; index++ {
+			shards[index] = shardID
+			eligible[index] = true
+		}
+	}
+
+	currentShard := uint32(0)
+	for ; index < numNodes; index++ {
+		currentShard = (currentShard + 1) % (numOfShards + 1)
+		shards[index] = currentShard
+		if currentShard == numOfShards {
+			shards[index] = mxCore.MetachainShardId
+		}
+	}
+
+	return shards, eligible
+}
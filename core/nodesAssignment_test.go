@@ -0,0 +1,178 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createInitialNodes(numNodes int) []*sharding.InitialNode {
+	validatorConverter, _ := pubkeyConverter.NewHexPubkeyConverter(96)
+	walletConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	initialNodes := make([]*sharding.InitialNode, 0, numNodes)
+	for i := 0; i < numNodes; i++ {
+		pkBytes := make([]byte, 96)
+		_, _ = rand.Read(pkBytes)
+		addressBytes := make([]byte, 32)
+		_, _ = rand.Read(addressBytes)
+
+		pubKey, _ := validatorConverter.Encode(pkBytes)
+		address, _ := walletConverter.Encode(addressBytes)
+		initialNodes = append(initialNodes, &sharding.InitialNode{
+			PubKey:        pubKey,
+			Address:       address,
+			InitialRating: 5000001,
+		})
+	}
+
+	return initialNodes
+}
+
+// loadNodesSetupAssignments lets the node's sharding.NodesSetup process the initial nodes and returns the resulting
+// shard and role of each public key
+func loadNodesSetupAssignments(t *testing.T, arg ArgNodesAssignment) map[string]*NodeAssignment {
+	validatorConverter, _ := pubkeyConverter.NewHexPubkeyConverter(96)
+	walletConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	nodesSetup := &sharding.NodesSetup{
+		ConsensusGroupSize:          1,
+		MinNodesPerShard:            arg.MinNodesPerShard,
+		MetaChainConsensusGroupSize: 1,
+		MetaChainMinNodes:           arg.MetaChainMinNodes,
+		Hysteresis:                  arg.Hysteresis,
+		InitialNodes:                arg.InitialNodes,
+	}
+	buff, err := json.Marshal(nodesSetup)
+	require.Nil(t, err)
+	filePath := filepath.Join(t.TempDir(), "nodesSetup.json")
+	require.Nil(t, os.WriteFile(filePath, buff, 0644))
+
+	loaded, err := sharding.NewNodesSetup(filePath, walletConverter, validatorConverter, arg.NumOfShards)
+	require.Nil(t, err)
+
+	assignments := make(map[string]*NodeAssignment)
+	addAssignments := func(nodesInfo map[uint32][]nodesCoordinator.GenesisNodeInfoHandler, role string) {
+		for shardID, nodes := range nodesInfo {
+			for _, node := range nodes {
+				pubKey, _ := validatorConverter.Encode(node.PubKeyBytes())
+				assignments[pubKey] = &NodeAssignment{
+					ShardID: shardID,
+					Role:    role,
+				}
+			}
+		}
+	}
+	eligible, waiting := loaded.InitialNodesInfo()
+	addAssignments(eligible, EligibleRole)
+	addAssignments(waiting, WaitingRole)
+
+	return assignments
+}
+
+func TestComputeNodesAssignment_ShouldMatchNodesSetup(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		numNodes          int
+		numOfShards       uint32
+		minNodesPerShard  uint32
+		metaChainMinNodes uint32
+		hysteresis        float32
+	}{
+		{numNodes: 36, numOfShards: 3, minNodesPerShard: 7, metaChainMinNodes: 7, hysteresis: 0.2},
+		{numNodes: 4, numOfShards: 1, minNodesPerShard: 2, metaChainMinNodes: 2, hysteresis: 0},
+		{numNodes: 50, numOfShards: 3, minNodesPerShard: 10, metaChainMinNodes: 10, hysteresis: 0.2},
+		{numNodes: 31, numOfShards: 2, minNodesPerShard: 5, metaChainMinNodes: 4, hysteresis: 0.5},
+		{numNodes: 45, numOfShards: 1, minNodesPerShard: 10, metaChainMinNodes: 10, hysteresis: 0.2},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		name := fmt.Sprintf("%d nodes, %d shards, hysteresis %v", tc.numNodes, tc.numOfShards, tc.hysteresis)
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			arg := ArgNodesAssignment{
+				InitialNodes:      createInitialNodes(tc.numNodes),
+				NumOfShards:       tc.numOfShards,
+				MinNodesPerShard:  tc.minNodesPerShard,
+				MetaChainMinNodes: tc.metaChainMinNodes,
+				Hysteresis:        tc.hysteresis,
+			}
+
+			assignments, err := ComputeNodesAssignment(arg)
+			require.Nil(t, err)
+			require.Equal(t, tc.numNodes, len(assignments))
+
+			expectedAssignments := loadNodesSetupAssignments(t, arg)
+			for _, assignment := range assignments {
+				expected := expectedAssignments[assignment.PubKey]
+				require.NotNil(t, expected, assignment.PubKey)
+				assert.Equal(t, expected.ShardID, assignment.ShardID, assignment.PubKey)
+				assert.Equal(t, expected.Role, assignment.Role, assignment.PubKey)
+			}
+		})
+	}
+}
+
+func TestComputeNodesAssignment_ObserversShouldBeGroupedByShard(t *testing.T) {
+	t.Parallel()
+
+	arg := ArgNodesAssignment{
+		InitialNodes:            createInitialNodes(4),
+		ObserverPubKeys:         []string{"obs0", "obs1", "obs2", "obs3", "obs4"},
+		NumOfShards:             2,
+		MinNodesPerShard:        1,
+		MetaChainMinNodes:       2,
+		NumOfObserversPerShard:  2,
+		NumOfMetachainObservers: 1,
+	}
+
+	assignments, err := ComputeNodesAssignment(arg)
+	require.Nil(t, err)
+	require.Equal(t, 9, len(assignments))
+
+	// validators: 2 on meta, 1 on shard 0, 1 on shard 1
+	observers := assignments[4:]
+	expectedShards := []uint32{0, 0, 1, 1, mxCore.MetachainShardId}
+	expectedIndexes := []uint32{1, 2, 1, 2, 2}
+	for i, observer := range observers {
+		assert.Equal(t, arg.ObserverPubKeys[i], observer.PubKey)
+		assert.Equal(t, expectedShards[i], observer.ShardID)
+		assert.Equal(t, expectedIndexes[i], observer.Index)
+		assert.Equal(t, ObserverRole, observer.Role)
+		assert.Empty(t, observer.Address)
+	}
+}
+
+func TestComputeNodesAssignment_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := ArgNodesAssignment{
+		InitialNodes:      createInitialNodes(3),
+		NumOfShards:       1,
+		MinNodesPerShard:  2,
+		MetaChainMinNodes: 2,
+	}
+	assignments, err := ComputeNodesAssignment(arg)
+	assert.Nil(t, assignments)
+	assert.True(t, errors.Is(err, ErrNotEnoughNodes))
+
+	arg.InitialNodes = createInitialNodes(4)
+	arg.ObserverPubKeys = []string{"obs0"}
+	assignments, err = ComputeNodesAssignment(arg)
+	assert.Nil(t, assignments)
+	assert.True(t, errors.Is(err, ErrInvalidNumberOfObservers))
+}
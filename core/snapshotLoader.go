@@ -0,0 +1,247 @@
+package core
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+)
+
+const (
+	snapshotAddressColumn   = "address"
+	snapshotBalanceColumn   = "balance"
+	snapshotStakedColumn    = "staked"
+	snapshotDelegatedColumn = "delegated"
+	snapshotCommentChar     = '#'
+	csvFileExtension        = ".csv"
+	jsonFileExtension       = ".json"
+)
+
+// SnapshotAccount represents an account of an exported balances snapshot
+type SnapshotAccount struct {
+	Address   string `json:"address"`
+	Balance   string `json:"balance"`
+	Staked    string `json:"staked"`
+	Delegated string `json:"delegated"`
+}
+
+// LoadSnapshot will load the accounts of an exported balances snapshot from the provided file and will convert them
+// in initial accounts. The file format is given by its extension: a JSON list of accounts or a CSV file whose header
+// contains the address and balance columns and, optionally, the staked and delegated columns. The snapshot validators
+// and delegation contracts are not part of the generated network, so the staked and delegated amounts are unlocked
+// and added to the balance of each account. If the target supply is provided, the balances are scaled so that they
+// sum up exactly to it
+func LoadSnapshot(
+	filePath string,
+	targetSupply *big.Int,
+	denomination int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) ([]mxData.InitialAccount, error) {
+	if check.IfNil(walletPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	snapshotAccounts, err := readSnapshotAccounts(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshotAccounts) == 0 {
+		return nil, ErrEmptySnapshot
+	}
+
+	initialAccounts := make([]mxData.InitialAccount, 0, len(snapshotAccounts))
+	addresses := make(map[string]struct{})
+	for i, snapshotAccount := range snapshotAccounts {
+		account, errConvert := convertSnapshotAccount(snapshotAccount, denomination, walletPubKeyConverter)
+		if errConvert != nil {
+			return nil, fmt.Errorf("%w for the snapshot account at index %d", errConvert, i)
+		}
+
+		_, found := addresses[account.Address]
+		if found {
+			return nil, fmt.Errorf("%w %s for the snapshot account at index %d", ErrDuplicatedAddress, account.Address, i)
+		}
+		addresses[account.Address] = struct{}{}
+
+		initialAccounts = append(initialAccounts, account)
+	}
+
+	if targetSupply == nil {
+		return initialAccounts, nil
+	}
+
+	err = scaleBalances(initialAccounts, targetSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	return initialAccounts, nil
+}
+
+func readSnapshotAccounts(filePath string) ([]*SnapshotAccount, error) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case jsonFileExtension:
+		snapshotAccounts := make([]*SnapshotAccount, 0)
+		err := mxCore.LoadJsonFile(&snapshotAccounts, filePath)
+
+		return snapshotAccounts, err
+	case csvFileExtension:
+		return readSnapshotCSV(filePath)
+	default:
+		return nil, fmt.Errorf("%w for file %s, expected a %s or a %s file",
+			ErrUnknownSnapshotFormat, filePath, csvFileExtension, jsonFileExtension)
+	}
+}
+
+func readSnapshotCSV(filePath string) ([]*SnapshotAccount, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := csv.NewReader(file)
+	reader.Comment = snapshotCommentChar
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return make([]*SnapshotAccount, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{snapshotAddressColumn, snapshotBalanceColumn} {
+		_, found := columns[name]
+		if !found {
+			return nil, fmt.Errorf("%w %s in file %s", ErrMissingSnapshotColumn, name, filePath)
+		}
+	}
+
+	snapshotAccounts := make([]*SnapshotAccount, 0)
+	for {
+		record, errRead := reader.Read()
+		if errRead == io.EOF {
+			return snapshotAccounts, nil
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		snapshotAccounts = append(snapshotAccounts, &SnapshotAccount{
+			Address:   csvField(record, columns, snapshotAddressColumn),
+			Balance:   csvField(record, columns, snapshotBalanceColumn),
+			Staked:    csvField(record, columns, snapshotStakedColumn),
+			Delegated: csvField(record, columns, snapshotDelegatedColumn),
+		})
+	}
+}
+
+func csvField(record []string, columns map[string]int, name string) string {
+	index, found := columns[name]
+	if !found {
+		return ""
+	}
+
+	return strings.TrimSpace(record[index])
+}
+
+func convertSnapshotAccount(
+	snapshotAccount *SnapshotAccount,
+	denomination int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) (mxData.InitialAccount, error) {
+	if snapshotAccount == nil {
+		return mxData.InitialAccount{}, ErrNilSnapshotAccount
+	}
+
+	_, err := walletPubKeyConverter.Decode(snapshotAccount.Address)
+	if err != nil {
+		return mxData.InitialAccount{}, fmt.Errorf("%w for address %s", err, snapshotAccount.Address)
+	}
+
+	balance, err := convertOptionalValue(snapshotAccount.Balance, denomination)
+	if err != nil {
+		return mxData.InitialAccount{}, fmt.Errorf("%w for the balance of %s", err, snapshotAccount.Address)
+	}
+	staked, err := convertOptionalValue(snapshotAccount.Staked, denomination)
+	if err != nil {
+		return mxData.InitialAccount{}, fmt.Errorf("%w for the staked value of %s", err, snapshotAccount.Address)
+	}
+	delegated, err := convertOptionalValue(snapshotAccount.Delegated, denomination)
+	if err != nil {
+		return mxData.InitialAccount{}, fmt.Errorf("%w for the delegated value of %s", err, snapshotAccount.Address)
+	}
+
+	balance.Add(balance, staked)
+	balance.Add(balance, delegated)
+
+	return mxData.InitialAccount{
+		Address:      snapshotAccount.Address,
+		Supply:       big.NewInt(0).Set(balance),
+		Balance:      balance,
+		StakingValue: big.NewInt(0),
+		Delegation: &mxData.DelegationData{
+			Address: "",
+			Value:   big.NewInt(0),
+		},
+	}, nil
+}
+
+// scaleBalances will scale the balances of the provided accounts so that they sum up to the target supply. Each
+// balance is scaled down to an integer value and the units lost this way are given, one by one, to the accounts with
+// the largest fractional parts
+func scaleBalances(initialAccounts []mxData.InitialAccount, targetSupply *big.Int) error {
+	if targetSupply.Sign() < 0 {
+		return fmt.Errorf("%w for the snapshot target supply %s", ErrInvalidValue, targetSupply.String())
+	}
+
+	snapshotSupply := ComputeTotalSupply(initialAccounts)
+	if snapshotSupply.Sign() == 0 {
+		return fmt.Errorf("%w, a snapshot without balances can not be scaled", ErrInvalidValue)
+	}
+
+	remainders := make([]*big.Int, len(initialAccounts))
+	scaledSupply := big.NewInt(0)
+	for i := range initialAccounts {
+		scaled := big.NewInt(0).Mul(initialAccounts[i].Balance, targetSupply)
+		remainders[i] = big.NewInt(0)
+		scaled.QuoRem(scaled, snapshotSupply, remainders[i])
+		scaledSupply.Add(scaledSupply, scaled)
+
+		initialAccounts[i].Balance = scaled
+		initialAccounts[i].Supply = big.NewInt(0).Set(scaled)
+	}
+
+	indexes := make([]int, len(initialAccounts))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return remainders[indexes[i]].Cmp(remainders[indexes[j]]) > 0
+	})
+
+	numMissingUnits := big.NewInt(0).Sub(targetSupply, scaledSupply).Int64()
+	for i := int64(0); i < numMissingUnits; i++ {
+		account := &initialAccounts[indexes[i]]
+		account.Balance.Add(account.Balance, big.NewInt(1))
+		account.Supply.Add(account.Supply, big.NewInt(1))
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSnapshotAddress3 = "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80"
+
+func createSnapshotFile(t *testing.T, fileName string, content string) string {
+	filePath := filepath.Join(t.TempDir(), fileName)
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestLoadSnapshot_NilConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts, err := LoadSnapshot("snapshot.csv", nil, DefaultDenomination, nil)
+	assert.Nil(t, accounts)
+	assert.Equal(t, ErrNilPubKeyConverter, err)
+}
+
+func TestLoadSnapshot_InvalidFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := createSnapshotFile(t, "snapshot.txt", "address,balance")
+		accounts, err := LoadSnapshot(filePath, nil, DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrUnknownSnapshotFormat))
+	})
+	t.Run("empty json file", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := createSnapshotFile(t, "snapshot.json", "[]")
+		accounts, err := LoadSnapshot(filePath, nil, DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.Equal(t, ErrEmptySnapshot, err)
+	})
+	t.Run("csv file without accounts", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := createSnapshotFile(t, "snapshot.csv", "address,balance\n")
+		accounts, err := LoadSnapshot(filePath, nil, DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.Equal(t, ErrEmptySnapshot, err)
+	})
+	t.Run("missing balance column", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := createSnapshotFile(t, "snapshot.csv", "address,staked\n"+testAllocationAddress1+",10\n")
+		accounts, err := LoadSnapshot(filePath, nil, DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrMissingSnapshotColumn))
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := createSnapshotFile(t, "snapshot.csv", "address,balance\nerd1invalid,10\n")
+		accounts, err := LoadSnapshot(filePath, nil, DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.NotNil(t, err)
+	})
+	t.Run("negative staked value", func(t *testing.T) {
+		t.Parallel()
+
+		content := `[{"address": "` + testAllocationAddress1 + `", "balance": "10", "staked": "-5"}]`
+		filePath := createSnapshotFile(t, "snapshot.json", content)
+		accounts, err := LoadSnapshot(filePath, nil, DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrNegativeValue))
+	})
+	t.Run("duplicated address", func(t *testing.T) {
+		t.Parallel()
+
+		content := "address,balance\n" + testAllocationAddress1 + ",10\n" + testAllocationAddress1 + ",20\n"
+		filePath := createSnapshotFile(t, "snapshot.csv", content)
+		accounts, err := LoadSnapshot(filePath, nil, DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrDuplicatedAddress))
+	})
+	t.Run("scaling a snapshot without balances", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := createSnapshotFile(t, "snapshot.csv", "address,balance\n"+testAllocationAddress1+",0\n")
+		accounts, err := LoadSnapshot(filePath, big.NewInt(100), DefaultDenomination, converter)
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+}
+
+func TestLoadSnapshot_CSVShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	content := "# exported at block 1234\n" +
+		"Address, Nonce, Balance, Staked, Delegated\n" +
+		testAllocationAddress1 + ", 5, 1000, 2500, \n" +
+		testAllocationAddress2 + ", 0, 10, , 300\n"
+
+	accounts, err := LoadSnapshot(createSnapshotFile(t, "snapshot.csv", content), nil, DefaultDenomination, converter)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(accounts))
+
+	assert.Equal(t, testAllocationAddress1, accounts[0].Address)
+	assert.Equal(t, big.NewInt(3500), accounts[0].Supply)
+	assert.Equal(t, big.NewInt(3500), accounts[0].Balance)
+	assert.Equal(t, big.NewInt(0), accounts[0].StakingValue)
+	assert.Equal(t, "", accounts[0].Delegation.Address)
+	assert.Equal(t, big.NewInt(0), accounts[0].Delegation.Value)
+
+	assert.Equal(t, testAllocationAddress2, accounts[1].Address)
+	assert.Equal(t, big.NewInt(310), accounts[1].Supply)
+	assert.Equal(t, big.NewInt(310), accounts[1].Balance)
+}
+
+func TestLoadSnapshot_JSONShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	content := `[
+	{"address": "` + testAllocationAddress1 + `", "balance": "1.5K EGLD", "delegated": "0.5K EGLD"},
+	{"address": "` + testAllocationAddress2 + `", "balance": "20 EGLD"}
+]`
+
+	accounts, err := LoadSnapshot(createSnapshotFile(t, "snapshot.json", content), nil, 3, converter)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(accounts))
+
+	assert.Equal(t, big.NewInt(2000000), accounts[0].Balance)
+	assert.Equal(t, big.NewInt(2000000), accounts[0].Supply)
+	assert.Equal(t, big.NewInt(20000), accounts[1].Balance)
+	assert.Equal(t, big.NewInt(20000), accounts[1].Supply)
+}
+
+func TestLoadSnapshot_ScalingShouldMatchTheTargetSupply(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	content := "address,balance\n" +
+		testAllocationAddress1 + ",1\n" +
+		testAllocationAddress2 + ",1\n" +
+		testSnapshotAddress3 + ",1\n"
+	filePath := createSnapshotFile(t, "snapshot.csv", content)
+
+	t.Run("scale up", func(t *testing.T) {
+		t.Parallel()
+
+		// 100 / 3 = 33 remainder 1, the first account receiving the missing unit as all the remainders are equal
+		accounts, err := LoadSnapshot(filePath, big.NewInt(100), DefaultDenomination, converter)
+		require.Nil(t, err)
+
+		assert.Equal(t, big.NewInt(34), accounts[0].Balance)
+		assert.Equal(t, big.NewInt(33), accounts[1].Balance)
+		assert.Equal(t, big.NewInt(33), accounts[2].Balance)
+		for _, account := range accounts {
+			assert.Equal(t, account.Balance, account.Supply)
+		}
+		assert.Equal(t, big.NewInt(100), ComputeTotalSupply(accounts))
+	})
+	t.Run("scale down", func(t *testing.T) {
+		t.Parallel()
+
+		content := "address,balance\n" +
+			testAllocationAddress1 + ",600\n" +
+			testAllocationAddress2 + ",299\n" +
+			testSnapshotAddress3 + ",101\n"
+		accounts, err := LoadSnapshot(createSnapshotFile(t, "snapshot.csv", content), big.NewInt(10),
+			DefaultDenomination, converter)
+		require.Nil(t, err)
+
+		// 6.0, 2.99 and 1.01: the missing unit goes to the account with the largest fractional part
+		assert.Equal(t, big.NewInt(6), accounts[0].Balance)
+		assert.Equal(t, big.NewInt(3), accounts[1].Balance)
+		assert.Equal(t, big.NewInt(1), accounts[2].Balance)
+		assert.Equal(t, big.NewInt(10), ComputeTotalSupply(accounts))
+	})
+}
+
+func TestLoadSnapshot_ScaledStakedAndDelegatedAmountsShouldBeUnlocked(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	content := "address,balance,staked,delegated\n" +
+		testAllocationAddress1 + ",1000,3,7\n" +
+		testAllocationAddress2 + ",333,1,\n"
+
+	targetSupply := big.NewInt(20000000)
+	accounts, err := LoadSnapshot(createSnapshotFile(t, "snapshot.csv", content), targetSupply,
+		DefaultDenomination, converter)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(accounts))
+
+	// the staked and delegated amounts are unlocked, so no account is checked against the node price
+	for _, account := range accounts {
+		assert.Equal(t, account.Balance, account.Supply)
+		assert.Equal(t, big.NewInt(0), account.StakingValue)
+		assert.Equal(t, "", account.Delegation.Address)
+		assert.Equal(t, big.NewInt(0), account.Delegation.Value)
+	}
+	assert.Equal(t, targetSupply, ComputeTotalSupply(accounts))
+}
@@ -0,0 +1,142 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+const pemBlockMarker = "-----BEGIN"
+const commentMarker = "#"
+
+// LoadValidatorKeys will load the BLS keys from the provided file. The file can either be a PEM bundle, as the one
+// produced for the validatorKey.pem file, or a list of hex encoded public keys, one per line. In the latter case the
+// private keys will remain empty.
+func LoadValidatorKeys(
+	filePath string,
+	keyGen crypto.KeyGenerator,
+	validatorPubKeyConverter mxCore.PubkeyConverter,
+) ([]*data.BlsKey, error) {
+	if check.IfNil(keyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(validatorPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Contains(buff, []byte(pemBlockMarker)) {
+		return loadValidatorKeysFromPem(filePath, keyGen, validatorPubKeyConverter)
+	}
+
+	return loadValidatorPublicKeys(buff, keyGen, validatorPubKeyConverter)
+}
+
+func loadValidatorKeysFromPem(
+	filePath string,
+	keyGen crypto.KeyGenerator,
+	validatorPubKeyConverter mxCore.PubkeyConverter,
+) ([]*data.BlsKey, error) {
+	skList, pkList, err := loadKeyPairsFromPem(filePath, keyGen, validatorPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*data.BlsKey, 0, len(skList))
+	for i := range skList {
+		keys = append(keys, &data.BlsKey{
+			PubKeyBytes:  pkList[i],
+			PrivKeyBytes: skList[i],
+		})
+	}
+
+	return keys, nil
+}
+
+// loadKeyPairsFromPem will load all the secret keys from the provided PEM file, checking that each secret key derives
+// the public key found in its PEM header
+func loadKeyPairsFromPem(
+	filePath string,
+	keyGen crypto.KeyGenerator,
+	pubKeyConverter mxCore.PubkeyConverter,
+) ([][]byte, [][]byte, error) {
+	skHexList, pkStrings, err := mxCore.LoadAllKeysFromPemFile(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skList := make([][]byte, 0, len(skHexList))
+	pkList := make([][]byte, 0, len(skHexList))
+	for i, skHex := range skHexList {
+		skBytes, errDecode := hex.DecodeString(string(skHex))
+		if errDecode != nil {
+			return nil, nil, fmt.Errorf("%w for the secret key of %s", errDecode, pkStrings[i])
+		}
+
+		sk, errDecode := keyGen.PrivateKeyFromByteArray(skBytes)
+		if errDecode != nil {
+			return nil, nil, fmt.Errorf("%w for the secret key of %s", errDecode, pkStrings[i])
+		}
+
+		pkBytes, errDecode := sk.GeneratePublic().ToByteArray()
+		if errDecode != nil {
+			return nil, nil, fmt.Errorf("%w for the public key of %s", errDecode, pkStrings[i])
+		}
+
+		pkString, _ := pubKeyConverter.Encode(pkBytes)
+		if pkString != pkStrings[i] {
+			return nil, nil, fmt.Errorf("%w, the secret key at index %d derives %s, but the PEM header contains %s",
+				ErrPublicKeyMismatch, i, pkString, pkStrings[i])
+		}
+
+		skList = append(skList, skBytes)
+		pkList = append(pkList, pkBytes)
+	}
+
+	return skList, pkList, nil
+}
+
+func loadValidatorPublicKeys(
+	buff []byte,
+	keyGen crypto.KeyGenerator,
+	validatorPubKeyConverter mxCore.PubkeyConverter,
+) ([]*data.BlsKey, error) {
+	keys := make([]*data.BlsKey, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(buff))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, commentMarker) {
+			continue
+		}
+
+		pkBytes, err := validatorPubKeyConverter.Decode(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w on line %d", err, lineNumber)
+		}
+
+		err = keyGen.CheckPublicKeyValid(pkBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w on line %d", err, lineNumber)
+		}
+
+		keys = append(keys, &data.BlsKey{
+			PubKeyBytes: pkBytes,
+		})
+	}
+
+	return keys, scanner.Err()
+}
@@ -0,0 +1,115 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateBlsKeysBytes(numKeys int) ([][]byte, [][]byte) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sks := make([][]byte, 0, numKeys)
+	pks := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+		sks = append(sks, skBytes)
+		pks = append(pks, pkBytes)
+	}
+
+	return sks, pks
+}
+
+func TestLoadValidatorKeys_FromPemShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(96)
+	sks, pks := generateBlsKeysBytes(3)
+
+	outputDirectory := t.TempDir()
+	fh, err := NewFileHandler(outputDirectory, "validatorKey.pem")
+	require.Nil(t, err)
+	for i := range sks {
+		pkString, _ := converter.Encode(pks[i])
+		_ = fh.SaveSkToPemFile(pkString, sks[i])
+	}
+	fh.Close()
+
+	keys, err := LoadValidatorKeys(
+		filepath.Join(outputDirectory, "validatorKey.pem"),
+		signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		converter,
+	)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(keys))
+	for i, key := range keys {
+		assert.Equal(t, pks[i], key.PubKeyBytes)
+		assert.Equal(t, sks[i], key.PrivKeyBytes)
+	}
+}
+
+func TestLoadValidatorKeys_FromPemWithWrongHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(96)
+	sks, pks := generateBlsKeysBytes(2)
+
+	outputDirectory := t.TempDir()
+	fh, _ := NewFileHandler(outputDirectory, "validatorKey.pem")
+	pkString, _ := converter.Encode(pks[1])
+	_ = fh.SaveSkToPemFile(pkString, sks[0])
+	fh.Close()
+
+	keys, err := LoadValidatorKeys(
+		filepath.Join(outputDirectory, "validatorKey.pem"),
+		signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		converter,
+	)
+	assert.Nil(t, keys)
+	assert.True(t, errors.Is(err, ErrPublicKeyMismatch))
+}
+
+func TestLoadValidatorKeys_FromPublicKeysListShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(96)
+	_, pks := generateBlsKeysBytes(2)
+
+	lines := []string{"# operator keys", ""}
+	for _, pk := range pks {
+		pkString, _ := converter.Encode(pk)
+		lines = append(lines, "  "+pkString)
+	}
+	filePath := filepath.Join(t.TempDir(), "keys.txt")
+	_ = os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0644)
+
+	keys, err := LoadValidatorKeys(filePath, signing.NewKeyGenerator(mcl.NewSuiteBLS12()), converter)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(keys))
+	for i, key := range keys {
+		assert.Equal(t, pks[i], key.PubKeyBytes)
+		assert.Equal(t, 0, len(key.PrivKeyBytes))
+	}
+}
+
+func TestLoadValidatorKeys_FromPublicKeysListWithInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(96)
+	filePath := filepath.Join(t.TempDir(), "keys.txt")
+	_ = os.WriteFile(filePath, []byte("not a key\n"), 0644)
+
+	keys, err := LoadValidatorKeys(filePath, signing.NewKeyGenerator(mcl.NewSuiteBLS12()), converter)
+	assert.Nil(t, keys)
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "line 1"))
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+// LoadWalletKeys will load the wallet keys from the provided PEM bundle, as the one produced for the walletKey.pem or
+// delegators.pem files. Each secret key has to derive the address found in its PEM header
+func LoadWalletKeys(
+	filePath string,
+	keyGen crypto.KeyGenerator,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) ([]*data.WalletKey, error) {
+	if check.IfNil(keyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(walletPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	skList, pkList, err := loadKeyPairsFromPem(filePath, keyGen, walletPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*data.WalletKey, 0, len(skList))
+	for i := range skList {
+		keys = append(keys, &data.WalletKey{
+			PubKeyBytes:  pkList[i],
+			PrivKeyBytes: skList[i],
+		})
+	}
+
+	return keys, nil
+}
+
+// LoadWalletKeystores will load and decrypt the wallet keys from all the keystore files found in the provided
+// directory, as the ones produced for the walletKeys and delegators directories. Each secret key has to derive the
+// address found in its keystore. The keys are returned in the order of their file names
+func LoadWalletKeystores(
+	directory string,
+	passphrase string,
+	keyGen crypto.KeyGenerator,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) ([]*data.WalletKey, error) {
+	if check.IfNil(keyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(walletPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	filePaths, err := filepath.Glob(filepath.Join(directory, "*"+keystoreExtension))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filePaths)
+
+	keys := make([]*data.WalletKey, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		key, errLoad := loadWalletKeystore(filePath, passphrase, keyGen, walletPubKeyConverter)
+		if errLoad != nil {
+			return nil, fmt.Errorf("%w while loading %s", errLoad, filePath)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func loadWalletKeystore(
+	filePath string,
+	passphrase string,
+	keyGen crypto.KeyGenerator,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) (*data.WalletKey, error) {
+	keystore := &EncryptedKeyJSON{}
+	err := mxCore.LoadJsonFile(keystore, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	skBytes, err := DecryptKeystore(keystore, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	sk, err := keyGen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the secret key of %s", err, keystore.Bech32)
+	}
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, fmt.Errorf("%w for the public key of %s", err, keystore.Bech32)
+	}
+
+	address, _ := walletPubKeyConverter.Encode(pkBytes)
+	if address != keystore.Bech32 || hex.EncodeToString(pkBytes) != keystore.Address {
+		return nil, fmt.Errorf("%w, the secret key derives %s, but the keystore contains %s (%s)",
+			ErrPublicKeyMismatch, address, keystore.Bech32, keystore.Address)
+	}
+
+	return &data.WalletKey{
+		PubKeyBytes:  pkBytes,
+		PrivKeyBytes: skBytes,
+	}, nil
+}
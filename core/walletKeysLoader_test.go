@@ -0,0 +1,156 @@
+package core
+
+import (
+	"crypto/rand"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateWalletKeysBytes(numKeys int) ([][]byte, [][]byte) {
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	sks := make([][]byte, 0, numKeys)
+	pks := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+		sks = append(sks, skBytes)
+		pks = append(pks, pkBytes)
+	}
+
+	return sks, pks
+}
+
+func TestLoadWalletKeys_NilKeyGeneratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	keys, err := LoadWalletKeys("walletKey.pem", nil, converter)
+	assert.Nil(t, keys)
+	assert.Equal(t, ErrNilKeyGenerator, err)
+}
+
+func TestLoadWalletKeys_NilPubKeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys, err := LoadWalletKeys("walletKey.pem", signing.NewKeyGenerator(ed25519.NewEd25519()), nil)
+	assert.Nil(t, keys)
+	assert.Equal(t, ErrNilPubKeyConverter, err)
+}
+
+func TestLoadWalletKeys_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	sks, pks := generateWalletKeysBytes(3)
+
+	outputDirectory := t.TempDir()
+	fh, err := NewFileHandler(outputDirectory, "walletKey.pem")
+	require.Nil(t, err)
+	for i := range sks {
+		pkString, _ := converter.Encode(pks[i])
+		_ = fh.SaveSkToPemFile(pkString, sks[i])
+	}
+	fh.Close()
+
+	keys, err := LoadWalletKeys(
+		filepath.Join(outputDirectory, "walletKey.pem"),
+		signing.NewKeyGenerator(ed25519.NewEd25519()),
+		converter,
+	)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(keys))
+	for i, key := range keys {
+		assert.Equal(t, pks[i], key.PubKeyBytes)
+		assert.Equal(t, sks[i], key.PrivKeyBytes)
+	}
+}
+
+func TestLoadWalletKeys_WithWrongHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	sks, pks := generateWalletKeysBytes(2)
+
+	outputDirectory := t.TempDir()
+	fh, _ := NewFileHandler(outputDirectory, "walletKey.pem")
+	pkString, _ := converter.Encode(pks[1])
+	_ = fh.SaveSkToPemFile(pkString, sks[0])
+	fh.Close()
+
+	keys, err := LoadWalletKeys(
+		filepath.Join(outputDirectory, "walletKey.pem"),
+		signing.NewKeyGenerator(ed25519.NewEd25519()),
+		converter,
+	)
+	assert.Nil(t, keys)
+	assert.True(t, errors.Is(err, ErrPublicKeyMismatch))
+}
+
+func TestLoadWalletKeystores(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	sks, pks := generateWalletKeysBytes(3)
+
+	outputDirectory := t.TempDir()
+	kh, err := NewKeystoreHandler(outputDirectory, "passphrase", rand.Reader)
+	require.Nil(t, err)
+	addresses := make([]string, 0, len(sks))
+	for i := range sks {
+		address, _ := converter.Encode(pks[i])
+		require.Nil(t, kh.SaveSkToKeystoreFile(address, pks[i], sks[i]))
+		addresses = append(addresses, address)
+	}
+
+	t.Run("empty passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, errLoad := LoadWalletKeystores(outputDirectory, "", keyGen, converter)
+		assert.Nil(t, keys)
+		assert.Equal(t, ErrEmptyPassphrase, errLoad)
+	})
+	t.Run("wrong passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, errLoad := LoadWalletKeystores(outputDirectory, "wrong passphrase", keyGen, converter)
+		assert.Nil(t, keys)
+		assert.True(t, errors.Is(errLoad, ErrInvalidKeystorePassphrase))
+	})
+	t.Run("secret key not deriving the address should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		khMismatch, errCreate := NewKeystoreHandler(directory, "passphrase", rand.Reader)
+		require.Nil(t, errCreate)
+		require.Nil(t, khMismatch.SaveSkToKeystoreFile(addresses[0], pks[0], sks[1]))
+
+		keys, errLoad := LoadWalletKeystores(directory, "passphrase", keyGen, converter)
+		assert.Nil(t, keys)
+		assert.True(t, errors.Is(errLoad, ErrPublicKeyMismatch))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		keys, errLoad := LoadWalletKeystores(outputDirectory, "passphrase", keyGen, converter)
+		require.Nil(t, errLoad)
+		require.Equal(t, 3, len(keys))
+
+		loaded := make(map[string][]byte, len(keys))
+		for _, key := range keys {
+			address, _ := converter.Encode(key.PubKeyBytes)
+			loaded[address] = key.PrivKeyBytes
+		}
+		for i, address := range addresses {
+			assert.Equal(t, sks[i], loaded[address])
+		}
+	})
+}
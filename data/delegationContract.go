@@ -0,0 +1,9 @@
+package data
+
+// DelegationContract will hold the data of a delegation contract deployed at genesis
+type DelegationContract struct {
+	OwnerPubKeyBytes []byte
+	OwnerNonce       uint64
+	ScPubKeyBytes    []byte
+	VmType           string
+}
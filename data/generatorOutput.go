@@ -7,11 +7,13 @@ import (
 
 // GeneratorOutput represents the structure that will contain aggregated generated data
 type GeneratorOutput struct {
-	ValidatorBlsKeys []*BlsKey
-	ObserverBlsKeys  []*BlsKey
-	WalletKeys       []*WalletKey
-	AdditionalKeys   []*WalletKey
-	InitialAccounts  []data.InitialAccount
-	InitialNodes     []*sharding.InitialNode
-	DelegatorKeys    []*WalletKey
+	ValidatorBlsKeys    []*BlsKey
+	ObserverBlsKeys     []*BlsKey
+	WalletKeys          []*WalletKey
+	AdditionalKeys      []*WalletKey
+	InitialAccounts     []data.InitialAccount
+	InitialNodes        []*sharding.InitialNode
+	DelegatorKeys       []*WalletKey
+	DelegationContracts []*DelegationContract
+	Mnemonic            string
 }
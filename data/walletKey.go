@@ -11,4 +11,5 @@ type WalletKey struct {
 	DelegatedValue       *big.Int
 	DelegatedPubKeyBytes []byte
 	StakedValue          *big.Int
+	DerivationIndex      uint32
 }
@@ -0,0 +1,44 @@
+package filegen
+
+import (
+	"fmt"
+	"math/big"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+)
+
+// LoadAllocations will load the fixed genesis allocations, if the file path is provided, and will return them together
+// with the remaining total supply, the one available for the generated accounts
+func LoadAllocations(
+	filePath string,
+	totalSupply *big.Int,
+	denomination int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) ([]mxData.InitialAccount, *big.Int, error) {
+	if len(filePath) == 0 {
+		return make([]mxData.InitialAccount, 0), totalSupply, nil
+	}
+
+	allocations, err := core.LoadAllocations(filePath, denomination, walletPubKeyConverter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while loading the allocations from %s", err, filePath)
+	}
+
+	allocated := core.ComputeTotalSupply(allocations)
+	remainingSupply := big.NewInt(0).Sub(totalSupply, allocated)
+	if remainingSupply.Cmp(big.NewInt(0)) < 0 {
+		return nil, nil, fmt.Errorf("%w, total supply: %s, allocated: %s",
+			ErrAllocationsExceedTotalSupply, totalSupply.String(), allocated.String())
+	}
+
+	log.Info("loaded allocations",
+		"file", filePath,
+		"num allocations", len(allocations),
+		"allocated", core.FormatAmount(allocated, denomination),
+		"remaining supply", core.FormatAmount(remainingSupply, denomination),
+	)
+
+	return allocations, remainingSupply, nil
+}
@@ -0,0 +1,51 @@
+package filegen
+
+import (
+	"fmt"
+	"math/big"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	generation "github.com/multiversx/mx-chain-deploy-go/generate"
+)
+
+// CreateBalancePolicy will create the balance policy out of the economics section of the scenario. The treasury
+// percent is applied on the provided total supply
+func CreateBalancePolicy(
+	economicsConfig config.EconomicsConfig,
+	totalSupply *big.Int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) (generation.BalancePolicy, error) {
+	minOwnerBalance, err := core.ConvertAmount(economicsConfig.MinOwnerBalance, economicsConfig.Denomination)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the minimum owner balance", err)
+	}
+	minDelegatorBalance, err := core.ConvertAmount(economicsConfig.MinDelegatorBalance, economicsConfig.Denomination)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the minimum delegator balance", err)
+	}
+	minAdditionalBalance, err := core.ConvertAmount(economicsConfig.MinAdditionalBalance, economicsConfig.Denomination)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the minimum additional account balance", err)
+	}
+
+	var treasuryPubKeyBytes []byte
+	if len(economicsConfig.TreasuryAddress) > 0 {
+		treasuryPubKeyBytes, err = walletPubKeyConverter.Decode(economicsConfig.TreasuryAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the treasury address %s", err, economicsConfig.TreasuryAddress)
+		}
+	}
+
+	return generation.NewBalancePolicy(generation.ArgBalancePolicy{
+		MinOwnerBalance:      minOwnerBalance,
+		MinDelegatorBalance:  minDelegatorBalance,
+		MinAdditionalBalance: minAdditionalBalance,
+		RichestAccountMode:   economicsConfig.RichestAccount,
+		RemainderSink:        economicsConfig.RemainderSink,
+		TreasuryPubKeyBytes:  treasuryPubKeyBytes,
+		TreasuryPercent:      economicsConfig.TreasuryPercent,
+		TotalSupply:          totalSupply,
+	})
+}
@@ -0,0 +1,75 @@
+package filegen
+
+import (
+	"fmt"
+	"math/big"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/check"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxCommonFactory "github.com/multiversx/mx-chain-go/common/factory"
+	mxConfig "github.com/multiversx/mx-chain-go/config"
+)
+
+const walletPubKeyFormat = "bech32"
+const validatorPubKeyFormat = "hex"
+const egldHrp = "erd"
+
+// CheckGeneratedOutput runs the initial accounts checks and reconciles the initial nodes with the stake of the initial
+// accounts, logging every violation found
+func CheckGeneratedOutput(
+	generatedOutput *data.GeneratorOutput,
+	nodePrice *big.Int,
+	totalSupply *big.Int,
+	denomination int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+	validatorPubKeyConverter mxCore.PubkeyConverter,
+) error {
+	initialAccountChecker, err := check.NewInitialAccountsChecker(nodePrice, totalSupply, denomination)
+	if err != nil {
+		return err
+	}
+
+	generatorOutputChecker, err := check.NewGeneratorOutputChecker(check.ArgGeneratorOutputChecker{
+		NodePrice:                nodePrice,
+		WalletPubKeyConverter:    walletPubKeyConverter,
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+	})
+	if err != nil {
+		return err
+	}
+
+	report := initialAccountChecker.ValidateInitialAccounts(generatedOutput.InitialAccounts)
+	report.Merge(generatorOutputChecker.ValidateGeneratorOutput(generatedOutput))
+	for _, violation := range report.Violations {
+		log.Error("check violation", "code", violation.Code, "rule", violation.Rule, "address", violation.Address,
+			"key", violation.Key, "field", violation.Field, "expected", violation.Expected, "actual", violation.Actual)
+	}
+	if !report.IsValid() {
+		return fmt.Errorf("%w, %d violations found", ErrCheckFailed, len(report.Violations))
+	}
+
+	return nil
+}
+
+// CreatePubKeyConverters returns the validator and the wallet public key converters used by all the written files
+func CreatePubKeyConverters() (mxCore.PubkeyConverter, mxCore.PubkeyConverter, error) {
+	walletPubKeyConverter, err := mxCommonFactory.NewPubkeyConverter(mxConfig.PubkeyConfig{
+		Length: 32,
+		Type:   walletPubKeyFormat,
+		Hrp:    egldHrp,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for walletPubKeyConverter", err)
+	}
+
+	validatorPubKeyConverter, err := mxCommonFactory.NewPubkeyConverter(mxConfig.PubkeyConfig{
+		Length: 96,
+		Type:   validatorPubKeyFormat,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for validatorPubKeyConverter", err)
+	}
+
+	return validatorPubKeyConverter, walletPubKeyConverter, nil
+}
@@ -0,0 +1,30 @@
+package filegen
+
+import "errors"
+
+// ErrNilScenarioConfig signals that a nil scenario config was provided
+var ErrNilScenarioConfig = errors.New("nil scenario config")
+
+// ErrNilGeneratorOutput signals that a nil generator output was provided
+var ErrNilGeneratorOutput = errors.New("nil generator output")
+
+// ErrInvalidScenario signals that the scenario contains values the network can not be generated from
+var ErrInvalidScenario = errors.New("invalid scenario")
+
+// ErrInvalidNumPrivPubKeys signals that the network would not contain any validator
+var ErrInvalidNumPrivPubKeys = errors.New("invalid number of private/public keys to generate")
+
+// ErrInvalidNumOfNodes signals an invalid number of nodes in a shard, in the metachain or in a consensus group
+var ErrInvalidNumOfNodes = errors.New("invalid number of nodes in shard/metachain or in the consensus group")
+
+// ErrCheckFailed signals that the generated output did not pass the consistency checks
+var ErrCheckFailed = errors.New("the generated output failed the checks")
+
+// ErrAllocationsExceedTotalSupply signals that the fixed allocations exceed the total supply
+var ErrAllocationsExceedTotalSupply = errors.New("allocations exceed the total supply")
+
+// ErrSnapshotExceedsTotalSupply signals that the imported snapshot exceeds the total supply left after the allocations
+var ErrSnapshotExceedsTotalSupply = errors.New("snapshot exceeds the remaining total supply")
+
+// ErrMissingKeystorePassphrase signals that the keystore wallet key format was chosen without a passphrase
+var ErrMissingKeystorePassphrase = errors.New("missing keystore passphrase")
@@ -0,0 +1,252 @@
+// Package filegen generates the genesis files of a network out of a scenario config. The filegen binary is a thin
+// wrapper over this package, which can be used to create networks in-process as well, e.g. from integration tests
+package filegen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	"github.com/multiversx/mx-chain-deploy-go/generate/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const vmType = "0500"
+
+var log = logger.GetOrCreate("filegen")
+
+// Generate will generate the network described by the provided scenario, without writing any file. The scenario is
+// validated before any generator is built, so invalid values are returned as errors. The fixed allocations and the
+// balances snapshot, if any, are reserved from the total supply and appended to the generated accounts and the whole
+// output is checked before being returned
+func Generate(ctx context.Context, scenario *config.ScenarioConfig) (*data.GeneratorOutput, error) {
+	err := ValidateScenario(scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	numOfAdditionalAccountsValue := scenario.Economics.NumAdditionalAccounts
+	denominationValue := scenario.Economics.Denomination
+	stakeTypeString := scenario.Staking.StakeType
+	numDelegatedNodesValue := scenario.Staking.NumDelegatedNodes
+
+	numValidators, numObservers, err := ComputeNumNodes(scenario.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	totalSupplyValue, err := core.ConvertAmount(scenario.Economics.TotalSupply, denominationValue)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the total supply", err)
+	}
+
+	nodePriceValue, err := core.ConvertAmount(scenario.Economics.NodePrice, denominationValue)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the node price", err)
+	}
+
+	log.Info("economics",
+		"total supply", core.FormatAmount(totalSupplyValue, denominationValue),
+		"node price", core.FormatAmount(nodePriceValue, denominationValue),
+	)
+
+	validatorPubKeyConverter, walletPubKeyConverter, err := CreatePubKeyConverters()
+	if err != nil {
+		return nil, err
+	}
+
+	allocations, generatedSupplyValue, err := LoadAllocations(
+		scenario.Economics.AllocationsFile,
+		totalSupplyValue,
+		denominationValue,
+		walletPubKeyConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, generatedSupplyValue, err := LoadSnapshot(
+		scenario.Economics,
+		generatedSupplyValue,
+		nodePriceValue,
+		walletPubKeyConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	balancePolicyHandler, err := CreateBalancePolicy(scenario.Economics, totalSupplyValue, walletPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	keyGens, err := CreateKeyGenerators(scenario.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := CreateStakingPolicies(scenario, keyGens.IntRandomizer, walletPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	minDelegationValue, err := core.ConvertAmount(scenario.Staking.MinDelegation, denominationValue)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the minimum delegation", err)
+	}
+
+	delegatorDistributionHandler, err := factory.CreateDelegatorDistribution(
+		scenario.Staking.DelegatorDistribution,
+		minDelegationValue,
+		denominationValue,
+		keyGens.IntRandomizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	importedValidatorBlsKeys, err := loadImportedValidatorKeys(
+		scenario.Keys.ValidatorKeysFile,
+		keyGens.ValidatorKeyGenerator,
+		validatorPubKeyConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	argDataGenerator := factory.ArgDataGenerator{
+		KeyGeneratorForValidators: keyGens.ValidatorKeyGenerator,
+		KeyGeneratorForWallets:    keyGens.WalletKeyGenerator,
+		WalletPubKeyConverter:     walletPubKeyConverter,
+		ValidatorPubKeyConverter:  validatorPubKeyConverter,
+		NumValidatorBlsKeys:       uint(numValidators),
+		NumObserverBlsKeys:        uint(numObservers),
+		BalancePolicy:             balancePolicyHandler,
+		OwnerDistribution:         policies.OwnerDistribution,
+		TopUpPolicy:               policies.TopUpPolicy,
+		NumAdditionalWalletKeys:   uint(numOfAdditionalAccountsValue),
+		NodePrice:                 nodePriceValue,
+		TotalSupply:               generatedSupplyValue,
+		RatingProfile:             policies.RatingProfile,
+		GenerationType:            stakeTypeString,
+		DelegationProviders:       createDelegationProviders(scenario.Staking),
+		VmType:                    vmType,
+		DelegatorDistribution:     delegatorDistributionHandler,
+		NumDelegatedNodes:         numDelegatedNodesValue,
+		ImportedValidatorBlsKeys:  importedValidatorBlsKeys,
+	}
+
+	dataGenerator, err := factory.CreateDataGenerator(argDataGenerator)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	generatedOutput, err := dataGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	if keyGens.MnemonicKeyGenerator != nil {
+		SetDerivationIndexes(generatedOutput, keyGens.MnemonicKeyGenerator)
+	}
+	generatedOutput.InitialAccounts = append(generatedOutput.InitialAccounts, allocations...)
+	generatedOutput.InitialAccounts = append(generatedOutput.InitialAccounts, snapshot...)
+
+	err = CheckGeneratedOutput(
+		generatedOutput,
+		nodePriceValue,
+		totalSupplyValue,
+		denominationValue,
+		walletPubKeyConverter,
+		validatorPubKeyConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return generatedOutput, nil
+}
+
+// Write will feed the generated output to the output writers of the scenario, the classic files being written if no
+// writer is set, creating the output directory if needed. The delegators and the wallet mnemonic files are written only
+// if the generated output contains them, the mnemonic being written as plaintext only on explicit request
+func Write(scenario *config.ScenarioConfig, generatedOutput *data.GeneratorOutput) error {
+	if scenario == nil {
+		return ErrNilScenarioConfig
+	}
+	if generatedOutput == nil {
+		return ErrNilGeneratorOutput
+	}
+
+	err := prepareOutputDirectory(scenario.Output.Directory)
+	if err != nil {
+		return err
+	}
+
+	validatorPubKeyConverter, walletPubKeyConverter, err := CreatePubKeyConverters()
+	if err != nil {
+		return err
+	}
+
+	outputWriter, err := createOutputWriter(
+		scenario,
+		validatorPubKeyConverter,
+		walletPubKeyConverter,
+		len(generatedOutput.DelegatorKeys) > 0,
+		len(generatedOutput.Mnemonic) > 0,
+	)
+	if err != nil {
+		return err
+	}
+	defer outputWriter.Close()
+
+	return outputWriter.WriteData(*generatedOutput)
+}
+
+// ComputeNumNodes returns the number of validators, including the hysteresis nodes, and the number of observers of the
+// network described by the scenario
+func ComputeNumNodes(network config.NetworkConfig) (int, int, error) {
+	numValidatorsOnAShard := int(math.Ceil(float64(network.NumOfNodesInEachShard) * (1 + network.Hysteresis)))
+	numShardValidators := network.NumOfShards * numValidatorsOnAShard
+	numValidatorsOnMeta := int(math.Ceil(float64(network.MetachainConsensusGroupSize) * (1 + network.Hysteresis)))
+	numValidators := numShardValidators + numValidatorsOnMeta
+	numObservers := network.NumOfShards*network.NumOfObserversInEachShard + network.NumOfObserversInMetachain
+
+	invalidNumPrivPubKey := numValidators < 1 ||
+		network.NumOfShards < 1 ||
+		network.NumOfNodesInEachShard < 1 ||
+		network.NumOfMetachainNodes < 1
+	if invalidNumPrivPubKey {
+		return 0, 0, ErrInvalidNumPrivPubKeys
+	}
+
+	invalidNumOfNodes := network.ConsensusGroupSize < 1 ||
+		network.ConsensusGroupSize > network.NumOfNodesInEachShard ||
+		network.NumOfObserversInEachShard < 0 ||
+		network.MetachainConsensusGroupSize < 1 ||
+		network.MetachainConsensusGroupSize > network.NumOfMetachainNodes ||
+		network.NumOfObserversInMetachain < 0
+	if invalidNumOfNodes {
+		return 0, 0, ErrInvalidNumOfNodes
+	}
+
+	return numValidators, numObservers, nil
+}
+
+func prepareOutputDirectory(outputDirectory string) error {
+	_, err := os.Stat(outputDirectory)
+	if os.IsNotExist(err) {
+		return os.MkdirAll(outputDirectory, 0755)
+	}
+
+	return err
+}
@@ -0,0 +1,452 @@
+package filegen
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/generate/factory"
+	"github.com/multiversx/mx-chain-deploy-go/plugins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestScenarioConfig(outputDirectory string) *config.ScenarioConfig {
+	return &config.ScenarioConfig{
+		Version: config.CurrentScenarioVersion,
+		Output: config.OutputConfig{
+			Directory:       outputDirectory,
+			WalletKeyFormat: core.PemWalletKeyFormat,
+			Layout:          core.ClassicOutputLayout,
+		},
+		Network: config.NetworkConfig{
+			NumOfShards:                 2,
+			NumOfNodesInEachShard:       3,
+			ConsensusGroupSize:          2,
+			NumOfObserversInEachShard:   1,
+			NumOfMetachainNodes:         3,
+			MetachainConsensusGroupSize: 3,
+			NumOfObserversInMetachain:   1,
+			RoundDuration:               6000,
+			InitialRating:               5000001,
+			RatingProfile:               "fixed",
+		},
+		Economics: config.EconomicsConfig{
+			TotalSupply:          "20M EGLD",
+			NodePrice:            "2500 EGLD",
+			Denomination:         core.DefaultDenomination,
+			MinOwnerBalance:      "1 EGLD",
+			MinDelegatorBalance:  "1 EGLD",
+			MinAdditionalBalance: "1 EGLD",
+			RemainderSink:        core.OwnerRemainderSink,
+		},
+		Staking: config.StakingConfig{
+			StakeType:                core.StakedType,
+			MaxNumValidatorsPerOwner: 4,
+			OwnerDistribution:        "uniform",
+			TopUpPolicy:              "none",
+			DelegatorDistribution:    "equal",
+			MinDelegation:            "1 EGLD",
+			DelegationCap:            "0",
+		},
+		Keys: config.KeysConfig{
+			Seed: "filegen test",
+		},
+	}
+}
+
+func TestGenerate_NilScenarioShouldErr(t *testing.T) {
+	t.Parallel()
+
+	generatedOutput, err := Generate(context.Background(), nil)
+	assert.Nil(t, generatedOutput)
+	assert.Equal(t, ErrNilScenarioConfig, err)
+}
+
+func TestGenerate_InvalidNetworkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scenario := createTestScenarioConfig(t.TempDir())
+	scenario.Network.ConsensusGroupSize = 4
+
+	generatedOutput, err := Generate(context.Background(), scenario)
+	assert.Nil(t, generatedOutput)
+	assert.Equal(t, ErrInvalidNumOfNodes, err)
+}
+
+func TestGenerate_InvalidScenarioShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("oversized number of delegated nodes", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createTestScenarioConfig(t.TempDir())
+		scenario.Staking.StakeType = core.MixedType
+		scenario.Staking.NumDelegators = 10
+		scenario.Staking.DelegationOwnerPublicKey = "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80"
+		scenario.Staking.NumDelegatedNodes = 500
+
+		generatedOutput, err := Generate(context.Background(), scenario)
+		assert.Nil(t, generatedOutput)
+		assert.True(t, errors.Is(err, ErrInvalidScenario))
+	})
+	t.Run("oversized delegation providers", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createTestScenarioConfig(t.TempDir())
+		scenario.Staking.StakeType = core.DelegatedStakeType
+		scenario.Staking.DelegationProviders = []config.DelegationProviderConfig{
+			{
+				OwnerPublicKey: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+				NumNodes:       100,
+				NumDelegators:  1,
+			},
+		}
+
+		generatedOutput, err := Generate(context.Background(), scenario)
+		assert.Nil(t, generatedOutput)
+		assert.True(t, errors.Is(err, ErrInvalidScenario))
+	})
+	t.Run("negative number of additional accounts", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createTestScenarioConfig(t.TempDir())
+		scenario.Economics.NumAdditionalAccounts = -1
+
+		generatedOutput, err := Generate(context.Background(), scenario)
+		assert.Nil(t, generatedOutput)
+		assert.True(t, errors.Is(err, ErrInvalidScenario))
+	})
+	t.Run("negative hysteresis", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createTestScenarioConfig(t.TempDir())
+		scenario.Network.Hysteresis = -0.5
+
+		generatedOutput, err := Generate(context.Background(), scenario)
+		assert.Nil(t, generatedOutput)
+		assert.True(t, errors.Is(err, ErrInvalidScenario))
+	})
+}
+
+func TestGenerate_CanceledContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	generatedOutput, err := Generate(ctx, createTestScenarioConfig(t.TempDir()))
+	assert.Nil(t, generatedOutput)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestGenerate_ShouldBeDeterministicWithSeed(t *testing.T) {
+	t.Parallel()
+
+	scenario := createTestScenarioConfig(t.TempDir())
+	firstOutput, err := Generate(context.Background(), scenario)
+	require.Nil(t, err)
+	secondOutput, err := Generate(context.Background(), scenario)
+	require.Nil(t, err)
+
+	assert.Equal(t, 9, len(firstOutput.InitialNodes))
+	assert.Equal(t, 3, len(firstOutput.ObserverBlsKeys))
+	assert.Equal(t, firstOutput.InitialNodes, secondOutput.InitialNodes)
+	assert.Equal(t, firstOutput.InitialAccounts, secondOutput.InitialAccounts)
+}
+
+func TestGenerate_UnknownStakeTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scenario := createTestScenarioConfig(t.TempDir())
+	scenario.Staking.StakeType = "unknown"
+	generatedOutput, err := Generate(context.Background(), scenario)
+	assert.Nil(t, generatedOutput)
+	assert.True(t, errors.Is(err, factory.ErrUnknownGenerationType))
+
+	scenario.Staking.StakeType = core.StakedType + ":3"
+	generatedOutput, err = Generate(context.Background(), scenario)
+	assert.Nil(t, generatedOutput)
+	assert.True(t, errors.Is(err, factory.ErrInvalidGenerationParameters))
+}
+
+func TestGenerate_Allocations(t *testing.T) {
+	t.Parallel()
+
+	allocationAddress := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	createAllocationsFile := func(t *testing.T, content string) string {
+		filePath := filepath.Join(t.TempDir(), "allocations.json")
+		err := os.WriteFile(filePath, []byte(content), 0644)
+		require.Nil(t, err)
+
+		return filePath
+	}
+
+	t.Run("staking allocation should error", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createTestScenarioConfig(t.TempDir())
+		scenario.Economics.AllocationsFile = createAllocationsFile(t,
+			`[{"address": "`+allocationAddress+`", "balance": "10 EGLD", "stakingValue": "2500 EGLD"}]`)
+
+		generatedOutput, err := Generate(context.Background(), scenario)
+		assert.Nil(t, generatedOutput)
+		assert.True(t, errors.Is(err, core.ErrStakingAllocation))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		scenario := createTestScenarioConfig(t.TempDir())
+		scenario.Economics.AllocationsFile = createAllocationsFile(t,
+			`[{"address": "`+allocationAddress+`", "balance": "10 EGLD", "stakingValue": "0"}]`)
+
+		generatedOutput, err := Generate(context.Background(), scenario)
+		require.Nil(t, err)
+
+		found := false
+		for _, account := range generatedOutput.InitialAccounts {
+			if account.Address == allocationAddress {
+				found = true
+				assert.Equal(t, "10000000000000000000", account.Balance.String())
+				assert.Equal(t, "0", account.StakingValue.String())
+			}
+		}
+		assert.True(t, found)
+	})
+}
+
+func TestWrite_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := Write(nil, nil)
+	assert.Equal(t, ErrNilScenarioConfig, err)
+
+	err = Write(createTestScenarioConfig(t.TempDir()), nil)
+	assert.Equal(t, ErrNilGeneratorOutput, err)
+}
+
+func TestWrite_ShouldWriteTheGeneratedNetwork(t *testing.T) {
+	t.Parallel()
+
+	scenario := createTestScenarioConfig(filepath.Join(t.TempDir(), "output"))
+	generatedOutput, err := Generate(context.Background(), scenario)
+	require.Nil(t, err)
+
+	err = Write(scenario, generatedOutput)
+	require.Nil(t, err)
+
+	validatorPubKeyConverter, walletPubKeyConverter, _ := CreatePubKeyConverters()
+	loader, err := plugins.NewOutputLoader(plugins.ArgOutputLoader{
+		OutputDirectory:          scenario.Output.Directory,
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+		WalletPubKeyConverter:    walletPubKeyConverter,
+		NumOfShards:              uint32(scenario.Network.NumOfShards),
+	})
+	require.Nil(t, err)
+
+	loadedOutput, err := loader.LoadGeneratorOutput("")
+	require.Nil(t, err)
+	assert.Equal(t, generatedOutput.InitialAccounts, loadedOutput.InitialAccounts)
+	assert.Equal(t, generatedOutput.InitialNodes, loadedOutput.InitialNodes)
+	assert.Equal(t, len(generatedOutput.ObserverBlsKeys), len(loadedOutput.ObserverBlsKeys))
+}
+
+func TestWrite_UnknownOrDuplicatedOutputWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scenario := createTestScenarioConfig(filepath.Join(t.TempDir(), "output"))
+	generatedOutput, err := Generate(context.Background(), scenario)
+	require.Nil(t, err)
+
+	scenario.Output.Writers = []string{core.ClassicOutputWriter, "unknown"}
+	err = Write(scenario, generatedOutput)
+	assert.True(t, errors.Is(err, plugins.ErrUnknownOutputWriter))
+
+	scenario.Output.Writers = []string{core.ClassicOutputWriter + ":genesis.json"}
+	err = Write(scenario, generatedOutput)
+	assert.True(t, errors.Is(err, plugins.ErrInvalidOutputWriterParameters))
+
+	scenario.Output.Writers = []string{core.AccountsDumpOutputWriter, core.AccountsDumpOutputWriter + ":dump.jsonl"}
+	err = Write(scenario, generatedOutput)
+	assert.True(t, errors.Is(err, plugins.ErrDuplicatedOutputWriter))
+}
+
+func TestWrite_MultipleOutputWritersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	scenario := createTestScenarioConfig(filepath.Join(t.TempDir(), "output"))
+	scenario.Economics.NumAdditionalAccounts = 2
+	scenario.Output.Writers = []string{core.ClassicOutputWriter, core.AccountsDumpOutputWriter + ":dump.jsonl"}
+	generatedOutput, err := Generate(context.Background(), scenario)
+	require.Nil(t, err)
+
+	err = Write(scenario, generatedOutput)
+	require.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(scenario.Output.Directory, "genesis.json"))
+	assert.Nil(t, err)
+
+	file, err := os.Open(filepath.Join(scenario.Output.Directory, "dump.jsonl"))
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	accounts := make([]*plugins.AccountDump, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		account := &plugins.AccountDump{}
+		err = json.Unmarshal(scanner.Bytes(), account)
+		require.Nil(t, err)
+		accounts = append(accounts, account)
+	}
+	require.Nil(t, scanner.Err())
+
+	require.Equal(t, len(generatedOutput.InitialAccounts), len(accounts))
+	numAccountsPerKind := make(map[string]int)
+	numNodes := 0
+	for i, account := range accounts {
+		assert.Equal(t, generatedOutput.InitialAccounts[i].Address, account.Address)
+		assert.Equal(t, generatedOutput.InitialAccounts[i].Balance.String(), account.Balance)
+		numAccountsPerKind[account.Kind]++
+		numNodes += account.NumNodes
+	}
+	assert.Equal(t, len(generatedOutput.WalletKeys), numAccountsPerKind["owner"])
+	assert.Equal(t, 2, numAccountsPerKind["additional"])
+	assert.Equal(t, len(generatedOutput.InitialNodes), numNodes)
+}
+
+func TestWrite_SeededKeystoresShouldBeIdentical(t *testing.T) {
+	t.Parallel()
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.Nil(t, os.WriteFile(passphraseFile, []byte("passphrase"), 0600))
+
+	writeKeystores := func() map[string][]byte {
+		scenario := createTestScenarioConfig(filepath.Join(t.TempDir(), "output"))
+		scenario.Output.WalletKeyFormat = core.KeystoreWalletKeyFormat
+		scenario.Output.KeystorePassphraseFile = passphraseFile
+		generatedOutput, err := Generate(context.Background(), scenario)
+		require.Nil(t, err)
+		require.Nil(t, Write(scenario, generatedOutput))
+
+		keystoresDirectory := filepath.Join(scenario.Output.Directory, "walletKeys")
+		entries, err := os.ReadDir(keystoresDirectory)
+		require.Nil(t, err)
+		keystores := make(map[string][]byte)
+		for _, entry := range entries {
+			keystores[entry.Name()], err = os.ReadFile(filepath.Join(keystoresDirectory, entry.Name()))
+			require.Nil(t, err)
+		}
+
+		return keystores
+	}
+
+	firstKeystores := writeKeystores()
+	assert.NotEmpty(t, firstKeystores)
+	assert.Equal(t, firstKeystores, writeKeystores())
+}
+
+func TestCheckKeystorePassphrase(t *testing.T) {
+	t.Setenv(KeystorePassphraseEnvVar, "")
+
+	outputConfig := config.OutputConfig{
+		WalletKeyFormat: core.KeystoreWalletKeyFormat,
+	}
+	err := CheckKeystorePassphrase(outputConfig)
+	assert.True(t, errors.Is(err, ErrMissingKeystorePassphrase))
+
+	t.Setenv(KeystorePassphraseEnvVar, "passphrase")
+	err = CheckKeystorePassphrase(outputConfig)
+	assert.Nil(t, err)
+
+	err = CheckKeystorePassphrase(config.OutputConfig{WalletKeyFormat: core.PemWalletKeyFormat})
+	assert.Nil(t, err)
+}
+
+func TestWrite_WalletMnemonic(t *testing.T) {
+	t.Parallel()
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.Nil(t, os.WriteFile(passphraseFile, []byte("passphrase"), 0600))
+
+	writeMnemonicNetwork := func(walletKeyFormat string, plaintextMnemonic bool) (*config.ScenarioConfig, string) {
+		scenario := createTestScenarioConfig(filepath.Join(t.TempDir(), "output"))
+		scenario.Keys.UseMnemonic = true
+		scenario.Output.WalletKeyFormat = walletKeyFormat
+		scenario.Output.KeystorePassphraseFile = passphraseFile
+		scenario.Output.PlaintextMnemonic = plaintextMnemonic
+		generatedOutput, err := Generate(context.Background(), scenario)
+		require.Nil(t, err)
+		require.NotEmpty(t, generatedOutput.Mnemonic)
+		require.Nil(t, Write(scenario, generatedOutput))
+
+		return scenario, generatedOutput.Mnemonic
+	}
+	createArgOutputLoader := func(scenario *config.ScenarioConfig) plugins.ArgOutputLoader {
+		validatorPubKeyConverter, walletPubKeyConverter, _ := CreatePubKeyConverters()
+
+		return plugins.ArgOutputLoader{
+			OutputDirectory:          scenario.Output.Directory,
+			ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+			WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+			ValidatorPubKeyConverter: validatorPubKeyConverter,
+			WalletPubKeyConverter:    walletPubKeyConverter,
+			NumOfShards:              uint32(scenario.Network.NumOfShards),
+		}
+	}
+
+	t.Run("keystore format should encrypt the mnemonic", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, mnemonic := writeMnemonicNetwork(core.KeystoreWalletKeyFormat, false)
+		buff, err := os.ReadFile(filepath.Join(scenario.Output.Directory, "walletMnemonic.json"))
+		require.Nil(t, err)
+		assert.NotContains(t, string(buff), mnemonic)
+		assert.Contains(t, string(buff), "encryptedMnemonic")
+
+		loader, err := plugins.NewOutputLoader(createArgOutputLoader(scenario))
+		require.Nil(t, err)
+		_, err = loader.LoadWalletMnemonic("")
+		assert.True(t, errors.Is(err, core.ErrEmptyPassphrase))
+		_, err = loader.LoadWalletMnemonic("wrong passphrase")
+		assert.True(t, errors.Is(err, core.ErrInvalidKeystorePassphrase))
+
+		walletMnemonic, err := loader.LoadWalletMnemonic("passphrase")
+		require.Nil(t, err)
+		assert.Equal(t, mnemonic, walletMnemonic.Mnemonic)
+		assert.NotEmpty(t, walletMnemonic.Accounts)
+
+		loadedOutput, err := loader.LoadGeneratorOutput("passphrase")
+		require.Nil(t, err)
+		assert.Equal(t, mnemonic, loadedOutput.Mnemonic)
+	})
+	t.Run("pem format without the plaintext option should not write the mnemonic", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, _ := writeMnemonicNetwork(core.PemWalletKeyFormat, false)
+		assert.NoFileExists(t, filepath.Join(scenario.Output.Directory, "walletMnemonic.json"))
+	})
+	t.Run("pem format with the plaintext option should write the mnemonic", func(t *testing.T) {
+		t.Parallel()
+
+		scenario, mnemonic := writeMnemonicNetwork(core.PemWalletKeyFormat, true)
+		loader, err := plugins.NewOutputLoader(createArgOutputLoader(scenario))
+		require.Nil(t, err)
+		walletMnemonic, err := loader.LoadWalletMnemonic("")
+		require.Nil(t, err)
+		assert.Equal(t, mnemonic, walletMnemonic.Mnemonic)
+		assert.Nil(t, walletMnemonic.EncryptedMnemonic)
+	})
+}
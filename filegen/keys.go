@@ -0,0 +1,183 @@
+package filegen
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/random"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	generation "github.com/multiversx/mx-chain-deploy-go/generate"
+	"github.com/multiversx/mx-chain-deploy-go/generate/deterministic"
+)
+
+const validatorKeysPersonalization = "validator keys"
+const walletKeysPersonalization = "wallet keys"
+const intRandomizerPersonalization = "int randomizer"
+const walletMnemonicPersonalization = "wallet mnemonic"
+const keystoresPersonalization = "keystores"
+
+// MnemonicKeyGenerator is a wallet key generator deriving the keys from a mnemonic
+type MnemonicKeyGenerator interface {
+	crypto.KeyGenerator
+	DerivationIndex(pubKey []byte) (uint32, bool)
+	Mnemonic() string
+}
+
+// KeyGenerators holds the key generators and the randomizer used while generating a network. The mnemonic key
+// generator is set only when the wallet keys are derived from a mnemonic
+type KeyGenerators struct {
+	ValidatorKeyGenerator crypto.KeyGenerator
+	WalletKeyGenerator    crypto.KeyGenerator
+	IntRandomizer         generation.IntRandomizer
+	MnemonicKeyGenerator  MnemonicKeyGenerator
+}
+
+// CreateKeyGenerators will create the key generators out of the keys section of the scenario, seeded when a seed is
+// provided
+func CreateKeyGenerators(keysConfig config.KeysConfig) (*KeyGenerators, error) {
+	walletSuite := ed25519.NewEd25519()
+	walletKeyGenerator := signing.NewKeyGenerator(walletSuite)
+
+	validatorSuite := mcl.NewSuiteBLS12()
+	validatorKeyGenerator := signing.NewKeyGenerator(validatorSuite)
+
+	keyGens := &KeyGenerators{
+		ValidatorKeyGenerator: validatorKeyGenerator,
+		WalletKeyGenerator:    walletKeyGenerator,
+		IntRandomizer:         &random.ConcurrentSafeIntRandomizer{},
+	}
+
+	if len(keysConfig.Seed) > 0 {
+		err := applySeed(keyGens, keysConfig.Seed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mnemonic, err := loadOrGenerateMnemonic(keysConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(mnemonic) > 0 {
+		mkg, errCreate := deterministic.NewMnemonicKeyGenerator(walletKeyGenerator, mnemonic)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		log.Info("wallet keys will be derived from a mnemonic")
+		keyGens.WalletKeyGenerator = mkg
+		keyGens.MnemonicKeyGenerator = mkg
+	}
+
+	return keyGens, nil
+}
+
+func applySeed(keyGens *KeyGenerators, seedString string) error {
+	log.Warn("deterministic generation is enabled, the generated keys are only as secret as the provided seed")
+
+	validatorKeysReader, err := deterministic.NewRandomReader([]byte(seedString), validatorKeysPersonalization)
+	if err != nil {
+		return err
+	}
+	keyGens.ValidatorKeyGenerator, err = deterministic.NewKeyGenerator(keyGens.ValidatorKeyGenerator, validatorKeysReader)
+	if err != nil {
+		return err
+	}
+
+	walletKeysReader, err := deterministic.NewRandomReader([]byte(seedString), walletKeysPersonalization)
+	if err != nil {
+		return err
+	}
+	keyGens.WalletKeyGenerator, err = deterministic.NewKeyGenerator(keyGens.WalletKeyGenerator, walletKeysReader)
+	if err != nil {
+		return err
+	}
+
+	intRandomizerReader, err := deterministic.NewRandomReader([]byte(seedString), intRandomizerPersonalization)
+	if err != nil {
+		return err
+	}
+	keyGens.IntRandomizer, err = deterministic.NewIntRandomizer(intRandomizerReader)
+
+	return err
+}
+
+func loadOrGenerateMnemonic(keysConfig config.KeysConfig) (string, error) {
+	if len(keysConfig.MnemonicFile) > 0 {
+		buff, err := os.ReadFile(keysConfig.MnemonicFile)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(string(buff)), nil
+	}
+	if !keysConfig.UseMnemonic {
+		return "", nil
+	}
+
+	var entropyReader io.Reader = rand.Reader
+	if len(keysConfig.Seed) > 0 {
+		var err error
+		entropyReader, err = deterministic.NewRandomReader([]byte(keysConfig.Seed), walletMnemonicPersonalization)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return deterministic.GenerateMnemonic(entropyReader)
+}
+
+// SetDerivationIndexes will set the mnemonic of the generated output and the derivation index of each wallet key
+func SetDerivationIndexes(generatedOutput *data.GeneratorOutput, mkg MnemonicKeyGenerator) {
+	generatedOutput.Mnemonic = mkg.Mnemonic()
+
+	allWalletKeys := make([]*data.WalletKey, 0)
+	allWalletKeys = append(allWalletKeys, generatedOutput.WalletKeys...)
+	allWalletKeys = append(allWalletKeys, generatedOutput.DelegatorKeys...)
+	allWalletKeys = append(allWalletKeys, generatedOutput.AdditionalKeys...)
+	for _, key := range allWalletKeys {
+		index, found := mkg.DerivationIndex(key.PubKeyBytes)
+		if !found {
+			log.Warn("wallet key was not derived from the mnemonic", "public key", hex.EncodeToString(key.PubKeyBytes))
+			continue
+		}
+
+		key.DerivationIndex = index
+	}
+}
+
+func loadImportedValidatorKeys(
+	filePath string,
+	keyGen crypto.KeyGenerator,
+	validatorPubKeyConverter mxCore.PubkeyConverter,
+) ([]*data.BlsKey, error) {
+	if len(filePath) == 0 {
+		return nil, nil
+	}
+
+	keys, err := core.LoadValidatorKeys(filePath, keyGen, validatorPubKeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the validator keys from %s", err, filePath)
+	}
+
+	numKeysWithSecret := 0
+	for _, key := range keys {
+		if len(key.PrivKeyBytes) > 0 {
+			numKeysWithSecret++
+		}
+	}
+	log.Info("imported validator keys", "file", filePath, "num keys", len(keys), "num secret keys", numKeysWithSecret)
+
+	return keys, nil
+}
@@ -0,0 +1,154 @@
+package filegen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/generate/deterministic"
+	"github.com/multiversx/mx-chain-deploy-go/plugins"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+// KeystorePassphraseEnvVar is the environment variable holding the keystore passphrase, when no passphrase file is set
+const KeystorePassphraseEnvVar = "FILEGEN_KEYSTORE_PASSPHRASE"
+
+func createOutputWriter(
+	scenario *config.ScenarioConfig,
+	validatorPubKeyConverter mxCore.PubkeyConverter,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+	shouldOutputDelegatorsFile bool,
+	shouldOutputWalletMnemonicFile bool,
+) (plugins.OutputWriter, error) {
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(scenario.Network.NumOfShards), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	keystorePassphrase, err := loadKeystorePassphrase(scenario.Output)
+	if err != nil {
+		return nil, err
+	}
+	if scenario.Output.WalletKeyFormat != core.KeystoreWalletKeyFormat {
+		log.Warn("wallet keys will be written as plaintext, this should only be used for local test networks",
+			"format", scenario.Output.WalletKeyFormat)
+		shouldOutputWalletMnemonicFile = checkPlaintextMnemonic(scenario.Output, shouldOutputWalletMnemonicFile)
+	}
+
+	keystoreRandReader, err := createKeystoreRandReader(scenario.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationCap, err := core.ConvertAmount(scenario.Staking.DelegationCap, scenario.Economics.Denomination)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the delegation cap", err)
+	}
+
+	argOutputWriter := plugins.ArgOutputWriter{
+		OutputDirectory:                scenario.Output.Directory,
+		ValidatorPubKeyConverter:       validatorPubKeyConverter,
+		WalletPubKeyConverter:          walletPubKeyConverter,
+		ShardCoordinator:               shardCoordinator,
+		ShouldOutputTxgenAccountsFile:  scenario.Output.GenerateTxgenFile,
+		ShouldOutputDelegatorsFile:     shouldOutputDelegatorsFile,
+		ShouldOutputWalletMnemonicFile: shouldOutputWalletMnemonicFile,
+		WalletKeyFormat:                scenario.Output.WalletKeyFormat,
+		KeystorePassphrase:             keystorePassphrase,
+		KeystoreRandReader:             keystoreRandReader,
+		OutputLayout:                   scenario.Output.Layout,
+		RoundDuration:                  scenario.Network.RoundDuration,
+		ConsensusGroupSize:             scenario.Network.ConsensusGroupSize,
+		NumOfNodesPerShard:             scenario.Network.NumOfNodesInEachShard,
+		MetachainConsensusGroupSize:    scenario.Network.MetachainConsensusGroupSize,
+		NumOfMetachainNodes:            scenario.Network.NumOfMetachainNodes,
+		NumOfObserversPerShard:         scenario.Network.NumOfObserversInEachShard,
+		NumOfMetachainObservers:        scenario.Network.NumOfObserversInMetachain,
+		HysteresisValue:                float32(scenario.Network.Hysteresis),
+		AdaptivityValue:                scenario.Network.Adaptivity,
+		DelegationServiceFee:           scenario.Staking.DelegationServiceFee,
+		DelegationCap:                  delegationCap,
+		DelegationInitParameters:       scenario.Staking.DelegationInit,
+		DelegationVersion:              scenario.Staking.DelegationVersion,
+	}
+
+	writers := scenario.Output.Writers
+	if len(writers) == 0 {
+		writers = []string{core.ClassicOutputWriter}
+	}
+
+	return plugins.CreateOutputWriter(writers, argOutputWriter)
+}
+
+// checkPlaintextMnemonic returns true if the wallet mnemonic file can be written while the wallet keys are not written
+// as keystores, in which case the mnemonic can not be encrypted and is only written as plaintext on explicit request
+func checkPlaintextMnemonic(outputConfig config.OutputConfig, shouldOutputWalletMnemonicFile bool) bool {
+	if !shouldOutputWalletMnemonicFile {
+		return false
+	}
+	if !outputConfig.PlaintextMnemonic {
+		log.Warn("the wallet mnemonic will not be written as it can not be encrypted with this wallet key format, "+
+			"set wallet-mnemonic-plaintext (PlaintextMnemonic in the scenario file) to write it as plaintext",
+			"format", outputConfig.WalletKeyFormat)
+		return false
+	}
+
+	log.Warn("the wallet mnemonic will be written as plaintext, this should only be used for local test networks",
+		"format", outputConfig.WalletKeyFormat)
+
+	return true
+}
+
+// createKeystoreRandReader returns the reader of the keystores salts, IVs and ids, seeded when a seed is provided so
+// the same seed produces identical keystore files
+func createKeystoreRandReader(keysConfig config.KeysConfig) (io.Reader, error) {
+	if len(keysConfig.Seed) == 0 {
+		return rand.Reader, nil
+	}
+
+	return deterministic.NewRandomReader([]byte(keysConfig.Seed), keystoresPersonalization)
+}
+
+// CheckKeystorePassphrase will check that the keystore passphrase can be resolved when the wallet keys are written as
+// keystores. It is meant to be called before generating the network, so a missing passphrase does not fail the last
+// step of a long generation
+func CheckKeystorePassphrase(outputConfig config.OutputConfig) error {
+	_, err := loadKeystorePassphrase(outputConfig)
+
+	return err
+}
+
+func loadKeystorePassphrase(outputConfig config.OutputConfig) (string, error) {
+	if outputConfig.WalletKeyFormat != core.KeystoreWalletKeyFormat {
+		return "", nil
+	}
+
+	return ReadKeystorePassphrase(outputConfig)
+}
+
+// ReadKeystorePassphrase will read the keystore passphrase from the passphrase file of the output config or, if not
+// set, from the environment variable, regardless of the wallet key format. Should be used for reading back keystores
+func ReadKeystorePassphrase(outputConfig config.OutputConfig) (string, error) {
+	if len(outputConfig.KeystorePassphraseFile) > 0 {
+		buff, err := os.ReadFile(outputConfig.KeystorePassphraseFile)
+		if err != nil {
+			return "", fmt.Errorf("%w while reading the keystore passphrase file", err)
+		}
+
+		return strings.TrimRight(string(buff), "\r\n"), nil
+	}
+
+	passphrase := os.Getenv(KeystorePassphraseEnvVar)
+	if len(passphrase) == 0 {
+		return "", fmt.Errorf("%w, provide it through a passphrase file or the %s environment variable, "+
+			"or use the %s wallet key format for local test networks", ErrMissingKeystorePassphrase,
+			KeystorePassphraseEnvVar, core.PemWalletKeyFormat)
+	}
+
+	return passphrase, nil
+}
@@ -0,0 +1,67 @@
+package filegen
+
+import (
+	"fmt"
+	"math/big"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/check"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+)
+
+// LoadSnapshot will import the balances snapshot, if the file path is provided, scaled to the snapshot supply when
+// set, and will return the imported accounts together with the remaining total supply. The imported accounts are
+// reconciled with the initial accounts checker before any other account is generated
+func LoadSnapshot(
+	economics config.EconomicsConfig,
+	totalSupply *big.Int,
+	nodePrice *big.Int,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) ([]mxData.InitialAccount, *big.Int, error) {
+	if len(economics.SnapshotFile) == 0 {
+		return make([]mxData.InitialAccount, 0), totalSupply, nil
+	}
+
+	var targetSupply *big.Int
+	if len(economics.SnapshotSupply) > 0 {
+		var err error
+		targetSupply, err = core.ConvertAmount(economics.SnapshotSupply, economics.Denomination)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the snapshot supply", err)
+		}
+	}
+
+	snapshot, err := core.LoadSnapshot(economics.SnapshotFile, targetSupply, economics.Denomination,
+		walletPubKeyConverter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while loading the snapshot from %s", err, economics.SnapshotFile)
+	}
+
+	imported := core.ComputeTotalSupply(snapshot)
+	initialAccountsChecker, err := check.NewInitialAccountsChecker(nodePrice, imported, economics.Denomination)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = initialAccountsChecker.CheckInitialAccounts(snapshot)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while checking the snapshot from %s", err, economics.SnapshotFile)
+	}
+
+	remainingSupply := big.NewInt(0).Sub(totalSupply, imported)
+	if remainingSupply.Cmp(big.NewInt(0)) < 0 {
+		return nil, nil, fmt.Errorf("%w, remaining total supply: %s, snapshot: %s",
+			ErrSnapshotExceedsTotalSupply, totalSupply.String(), imported.String())
+	}
+
+	log.Info("imported balances snapshot",
+		"file", economics.SnapshotFile,
+		"num accounts", len(snapshot),
+		"imported", core.FormatAmount(imported, economics.Denomination),
+		"scaled", targetSupply != nil,
+		"remaining supply", core.FormatAmount(remainingSupply, economics.Denomination),
+	)
+
+	return snapshot, remainingSupply, nil
+}
@@ -0,0 +1,90 @@
+package filegen
+
+import (
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	generation "github.com/multiversx/mx-chain-deploy-go/generate"
+	"github.com/multiversx/mx-chain-deploy-go/generate/factory"
+)
+
+const delegationOwnerNonce = uint64(0)
+
+// StakingPolicies holds the policies deciding how the validators are spread between the owners, topped up and rated
+type StakingPolicies struct {
+	OwnerDistribution generation.OwnerDistribution
+	TopUpPolicy       generation.TopUpPolicy
+	RatingProfile     generation.RatingProfile
+}
+
+// CreateStakingPolicies will create the owner distribution, the top-up policy and the rating profile of the scenario
+func CreateStakingPolicies(
+	scenario *config.ScenarioConfig,
+	intRandomizer generation.IntRandomizer,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) (*StakingPolicies, error) {
+	if scenario == nil {
+		return nil, ErrNilScenarioConfig
+	}
+
+	ownerDistributionHandler, err := factory.CreateOwnerDistribution(
+		scenario.Staking.OwnerDistribution,
+		scenario.Staking.MaxNumValidatorsPerOwner,
+		intRandomizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	topUpPolicyHandler, err := factory.CreateTopUpPolicy(
+		scenario.Staking.TopUpPolicy,
+		scenario.Economics.Denomination,
+		intRandomizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ratingProfileHandler, err := factory.CreateRatingProfile(
+		scenario.Network.RatingProfile,
+		scenario.Network.RatingOverrides,
+		scenario.Network.InitialRating,
+		intRandomizer,
+		walletPubKeyConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StakingPolicies{
+		OwnerDistribution: ownerDistributionHandler,
+		TopUpPolicy:       topUpPolicyHandler,
+		RatingProfile:     ratingProfileHandler,
+	}, nil
+}
+
+// createDelegationProviders returns the delegation providers from the scenario. If none is defined, a single provider
+// holding all the delegated nodes is created out of the delegation owner public key and number of delegators options
+func createDelegationProviders(stakingConfig config.StakingConfig) []generation.DelegationProvider {
+	if len(stakingConfig.DelegationProviders) == 0 {
+		return []generation.DelegationProvider{
+			{
+				OwnerPkString: stakingConfig.DelegationOwnerPublicKey,
+				OwnerNonce:    delegationOwnerNonce,
+				NumNodes:      0,
+				NumDelegators: stakingConfig.NumDelegators,
+			},
+		}
+	}
+
+	providers := make([]generation.DelegationProvider, 0, len(stakingConfig.DelegationProviders))
+	for _, providerConfig := range stakingConfig.DelegationProviders {
+		providers = append(providers, generation.DelegationProvider{
+			OwnerPkString: providerConfig.OwnerPublicKey,
+			OwnerNonce:    providerConfig.OwnerNonce,
+			NumNodes:      providerConfig.NumNodes,
+			NumDelegators: providerConfig.NumDelegators,
+		})
+	}
+
+	return providers
+}
@@ -0,0 +1,63 @@
+package filegen
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/multiversx/mx-chain-deploy-go/config"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/generate/factory"
+)
+
+const stakeTypeParametersSeparator = ":"
+
+// ValidateScenario will check the values of the scenario that the generators rely on, so an invalid scenario is
+// reported as an error before any key is generated
+func ValidateScenario(scenario *config.ScenarioConfig) error {
+	if scenario == nil {
+		return ErrNilScenarioConfig
+	}
+
+	network := scenario.Network
+	if network.Hysteresis < 0 || math.IsNaN(network.Hysteresis) || math.IsInf(network.Hysteresis, 0) {
+		return fmt.Errorf("%w, hysteresis: %v", ErrInvalidScenario, network.Hysteresis)
+	}
+
+	numValidators, _, err := ComputeNumNodes(network)
+	if err != nil {
+		return err
+	}
+
+	economics := scenario.Economics
+	if economics.Denomination < 0 {
+		return fmt.Errorf("%w, denomination: %d", ErrInvalidScenario, economics.Denomination)
+	}
+	if economics.NumAdditionalAccounts < 0 {
+		return fmt.Errorf("%w, number of additional accounts: %d", ErrInvalidScenario,
+			economics.NumAdditionalAccounts)
+	}
+
+	staking := scenario.Staking
+	err = factory.CheckGenerationType(staking.StakeType)
+	if err != nil {
+		return err
+	}
+
+	stakeType, _, _ := strings.Cut(strings.TrimSpace(staking.StakeType), stakeTypeParametersSeparator)
+	if stakeType == core.MixedType && staking.NumDelegatedNodes > uint(numValidators) {
+		return fmt.Errorf("%w, number of delegated nodes: %d, number of validators: %d", ErrInvalidScenario,
+			staking.NumDelegatedNodes, numValidators)
+	}
+
+	numProvidersNodes := uint(0)
+	for _, provider := range staking.DelegationProviders {
+		numProvidersNodes += provider.NumNodes
+	}
+	if numProvidersNodes > uint(numValidators) {
+		return fmt.Errorf("%w, the delegation providers hold %d nodes, number of validators: %d", ErrInvalidScenario,
+			numProvidersNodes, numValidators)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-deploy-go/data"
 )
 
 // ArgDirectStakingGenerator is the argument used in direct staking mechanism
@@ -15,13 +16,14 @@ type ArgDirectStakingGenerator struct {
 	ValidatorPubKeyConverter  core.PubkeyConverter
 	NumValidatorBlsKeys       uint
 	NumObserverBlsKeys        uint
-	RichestAccountMode        bool
-	MaxNumNodesOnOwner        uint
+	BalancePolicy             BalancePolicy
+	OwnerDistribution         OwnerDistribution
+	TopUpPolicy               TopUpPolicy
 	NumAdditionalWalletKeys   uint
-	IntRandomizer             IntRandomizer
 	NodePrice                 *big.Int
 	TotalSupply               *big.Int
-	InitialRating             uint64
+	RatingProfile             RatingProfile
+	ImportedValidatorBlsKeys  []*data.BlsKey
 }
 
 // ArgDelegatedStakingGenerator is the argument used in delegated staking mechanism
@@ -32,21 +34,47 @@ type ArgDelegatedStakingGenerator struct {
 	ValidatorPubKeyConverter  core.PubkeyConverter
 	NumValidatorBlsKeys       uint
 	NumObserverBlsKeys        uint
-	RichestAccountMode        bool
+	BalancePolicy             BalancePolicy
 	NumAdditionalWalletKeys   uint
 	NodePrice                 *big.Int
 	TotalSupply               *big.Int
-	InitialRating             uint64
-	DelegationOwnerPkString   string
-	DelegationOwnerNonce      uint64
+	RatingProfile             RatingProfile
+	DelegationProviders       []DelegationProvider
 	VmType                    string
-	NumDelegators             uint
+	DelegatorDistribution     DelegatorDistribution
+	ImportedValidatorBlsKeys  []*data.BlsKey
 }
 
 // ArgMixedStakingGenerator is the argument used in mixed staking mechanism
 type ArgMixedStakingGenerator struct {
 	ArgDelegatedStakingGenerator
-	NumDelegatedNodes  uint
-	MaxNumNodesOnOwner uint
-	IntRandomizer      IntRandomizer
+	NumDelegatedNodes uint
+	OwnerDistribution OwnerDistribution
+	TopUpPolicy       TopUpPolicy
+}
+
+// DelegationProvider describes a staking provider, staking its nodes through its own delegation contract. A NumNodes
+// value of 0 means that the provider will hold all the delegated nodes not held by the other providers
+type DelegationProvider struct {
+	OwnerPkString string
+	OwnerNonce    uint64
+	NumNodes      uint
+	NumDelegators uint
+}
+
+// ArgNetworkExtender is the argument used to extend an existing network. The number of validators and observers are
+// the totals of the extended network, not the number of nodes to be added
+type ArgNetworkExtender struct {
+	KeyGeneratorForValidators crypto.KeyGenerator
+	KeyGeneratorForWallets    crypto.KeyGenerator
+	WalletPubKeyConverter     core.PubkeyConverter
+	ValidatorPubKeyConverter  core.PubkeyConverter
+	NumValidatorBlsKeys       uint
+	NumObserverBlsKeys        uint
+	BalancePolicy             BalancePolicy
+	OwnerDistribution         OwnerDistribution
+	TopUpPolicy               TopUpPolicy
+	NodePrice                 *big.Int
+	TotalSupply               *big.Int
+	RatingProfile             RatingProfile
 }
@@ -0,0 +1,201 @@
+package generate
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+const maxTreasuryPercent = 100
+
+// ArgBalancePolicy is the argument used to create a balance policy
+type ArgBalancePolicy struct {
+	MinOwnerBalance      *big.Int
+	MinDelegatorBalance  *big.Int
+	MinAdditionalBalance *big.Int
+	RichestAccountMode   bool
+	RemainderSink        string
+	TreasuryPubKeyBytes  []byte
+	TreasuryPercent      float64
+	TotalSupply          *big.Int
+}
+
+type balancePolicy struct {
+	minOwnerBalance      *big.Int
+	minDelegatorBalance  *big.Int
+	minAdditionalBalance *big.Int
+	richestAccountMode   bool
+	remainderSink        string
+	treasuryPubKeyBytes  []byte
+	treasuryBalance      *big.Int
+}
+
+// NewBalancePolicy will create a balance policy able to spread the genesis balance among the generated accounts.
+// Each account receives the minimum balance of its class, a treasury can reserve a percent of the total supply and the
+// rest is either split evenly between the owners and the additional accounts or, in the richest account mode, credited
+// to the remainder sink. The division remainder always goes to the remainder sink
+func NewBalancePolicy(arg ArgBalancePolicy) (*balancePolicy, error) {
+	err := checkMinimumBalance(arg.MinOwnerBalance, "owners")
+	if err != nil {
+		return nil, err
+	}
+	err = checkMinimumBalance(arg.MinDelegatorBalance, "delegators")
+	if err != nil {
+		return nil, err
+	}
+	err = checkMinimumBalance(arg.MinAdditionalBalance, "additional accounts")
+	if err != nil {
+		return nil, err
+	}
+	if arg.TotalSupply == nil {
+		return nil, ErrNilTotalSupply
+	}
+	if arg.TreasuryPercent < 0 || arg.TreasuryPercent > maxTreasuryPercent {
+		return nil, fmt.Errorf("%w for the treasury percent: %v", ErrInvalidValue, arg.TreasuryPercent)
+	}
+	hasTreasury := len(arg.TreasuryPubKeyBytes) > 0
+	if arg.TreasuryPercent > 0 && !hasTreasury {
+		return nil, fmt.Errorf("%w, a treasury percent was provided", ErrMissingTreasuryAddress)
+	}
+
+	switch arg.RemainderSink {
+	case core.OwnerRemainderSink, core.AdditionalRemainderSink:
+	case core.TreasuryRemainderSink:
+		if !hasTreasury {
+			return nil, fmt.Errorf("%w for the %s remainder sink", ErrMissingTreasuryAddress, arg.RemainderSink)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRemainderSink, arg.RemainderSink)
+	}
+
+	return &balancePolicy{
+		minOwnerBalance:      big.NewInt(0).Set(arg.MinOwnerBalance),
+		minDelegatorBalance:  big.NewInt(0).Set(arg.MinDelegatorBalance),
+		minAdditionalBalance: big.NewInt(0).Set(arg.MinAdditionalBalance),
+		richestAccountMode:   arg.RichestAccountMode,
+		remainderSink:        arg.RemainderSink,
+		treasuryPubKeyBytes:  arg.TreasuryPubKeyBytes,
+		treasuryBalance:      computeTreasuryBalance(arg.TotalSupply, arg.TreasuryPercent),
+	}, nil
+}
+
+func checkMinimumBalance(value *big.Int, accountsClass string) error {
+	if value == nil {
+		return fmt.Errorf("%w for the %s", ErrNilMinimumBalance, accountsClass)
+	}
+	if value.Cmp(zero) < 0 {
+		return fmt.Errorf("%w for the minimum balance of the %s", ErrInvalidValue, accountsClass)
+	}
+
+	return nil
+}
+
+// DelegatorBalance returns the balance given to each delegator so it can claim its rewards
+func (bp *balancePolicy) DelegatorBalance() *big.Int {
+	return big.NewInt(0).Set(bp.minDelegatorBalance)
+}
+
+// DistributeBalance will set the balance of the provided owners and additional accounts out of the provided balance.
+// It returns the treasury account, if one is defined, or nil otherwise
+func (bp *balancePolicy) DistributeBalance(
+	balance *big.Int,
+	owners []*data.WalletKey,
+	additionalKeys []*data.WalletKey,
+) (*data.WalletKey, error) {
+	remaining := big.NewInt(0).Set(balance)
+
+	var treasury *data.WalletKey
+	if len(bp.treasuryPubKeyBytes) > 0 {
+		treasury = &data.WalletKey{
+			PubKeyBytes: bp.treasuryPubKeyBytes,
+			Balance:     big.NewInt(0).Set(bp.treasuryBalance),
+		}
+		remaining.Sub(remaining, treasury.Balance)
+		if remaining.Cmp(zero) < 0 {
+			return nil, fmt.Errorf("%w, treasury balance: %s, available balance: %s", ErrTotalSupplyTooSmall,
+				treasury.Balance.String(), balance.String())
+		}
+	}
+
+	minimums := big.NewInt(0).Mul(bp.minOwnerBalance, big.NewInt(int64(len(owners))))
+	minimums.Add(minimums, big.NewInt(0).Mul(bp.minAdditionalBalance, big.NewInt(int64(len(additionalKeys)))))
+	remaining.Sub(remaining, minimums)
+	if remaining.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w, minimum balances: %s, available balance: %s", ErrTotalSupplyTooSmall,
+			minimums.String(), big.NewInt(0).Add(remaining, minimums).String())
+	}
+
+	for _, key := range owners {
+		key.Balance = big.NewInt(0).Set(bp.minOwnerBalance)
+	}
+	for _, key := range additionalKeys {
+		key.Balance = big.NewInt(0).Set(bp.minAdditionalBalance)
+	}
+
+	numAccounts := len(owners) + len(additionalKeys)
+	if !bp.richestAccountMode && numAccounts > 0 {
+		share := big.NewInt(0).Div(remaining, big.NewInt(int64(numAccounts)))
+		for _, key := range owners {
+			key.Balance.Add(key.Balance, share)
+		}
+		for _, key := range additionalKeys {
+			key.Balance.Add(key.Balance, share)
+		}
+		remaining.Mod(remaining, big.NewInt(int64(numAccounts)))
+	}
+
+	if remaining.Cmp(zero) == 0 {
+		return treasury, nil
+	}
+
+	sink := bp.selectRemainderSink(owners, additionalKeys, treasury)
+	if sink == nil {
+		return nil, fmt.Errorf("%w, remainder sink: %s, remainder: %s", ErrMissingRemainderSink,
+			bp.remainderSink, remaining.String())
+	}
+	sink.Balance.Add(sink.Balance, remaining)
+
+	return treasury, nil
+}
+
+func computeTreasuryBalance(totalSupply *big.Int, treasuryPercent float64) *big.Int {
+	treasuryRatio := big.NewRat(0, 1).SetFloat64(treasuryPercent)
+	treasuryRatio.Quo(treasuryRatio, big.NewRat(maxTreasuryPercent, 1))
+
+	treasuryBalance := big.NewRat(0, 1).SetInt(totalSupply)
+	treasuryBalance.Mul(treasuryBalance, treasuryRatio)
+
+	// the integer division truncates the fractional part
+	return big.NewInt(0).Quo(treasuryBalance.Num(), treasuryBalance.Denom())
+}
+
+func (bp *balancePolicy) selectRemainderSink(
+	owners []*data.WalletKey,
+	additionalKeys []*data.WalletKey,
+	treasury *data.WalletKey,
+) *data.WalletKey {
+	switch bp.remainderSink {
+	case core.OwnerRemainderSink:
+		if len(owners) > 0 {
+			return owners[0]
+		}
+		if len(additionalKeys) > 0 {
+			return additionalKeys[0]
+		}
+	case core.AdditionalRemainderSink:
+		if len(additionalKeys) > 0 {
+			return additionalKeys[0]
+		}
+	case core.TreasuryRemainderSink:
+		return treasury
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *balancePolicy) IsInterfaceNil() bool {
+	return bp == nil
+}
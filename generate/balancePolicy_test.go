@@ -0,0 +1,230 @@
+package generate
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMinimumBalance = big.NewInt(1000000000000000000) // 1eGLD
+
+func createTestBalancePolicy(minimumBalance *big.Int, richestAccountMode bool) BalancePolicy {
+	bp, _ := NewBalancePolicy(ArgBalancePolicy{
+		MinOwnerBalance:      minimumBalance,
+		MinDelegatorBalance:  minimumBalance,
+		MinAdditionalBalance: minimumBalance,
+		RichestAccountMode:   richestAccountMode,
+		RemainderSink:        core.OwnerRemainderSink,
+		TotalSupply:          big.NewInt(0),
+	})
+
+	return bp
+}
+
+func createMockArgBalancePolicy() ArgBalancePolicy {
+	return ArgBalancePolicy{
+		MinOwnerBalance:      big.NewInt(10),
+		MinDelegatorBalance:  big.NewInt(5),
+		MinAdditionalBalance: big.NewInt(1),
+		RichestAccountMode:   false,
+		RemainderSink:        core.OwnerRemainderSink,
+		TotalSupply:          big.NewInt(1000),
+	}
+}
+
+func createWalletKeys(numKeys int, prefix string) []*data.WalletKey {
+	keys := make([]*data.WalletKey, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		keys = append(keys, &data.WalletKey{
+			PubKeyBytes: []byte(prefix + string(rune('a'+i))),
+		})
+	}
+
+	return keys
+}
+
+func TestNewBalancePolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.MinDelegatorBalance = nil
+		bp, err := NewBalancePolicy(arg)
+		assert.Nil(t, bp)
+		assert.True(t, errors.Is(err, ErrNilMinimumBalance))
+	})
+	t.Run("nil total supply should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.TotalSupply = nil
+		bp, err := NewBalancePolicy(arg)
+		assert.Nil(t, bp)
+		assert.Equal(t, ErrNilTotalSupply, err)
+	})
+	t.Run("negative minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.MinOwnerBalance = big.NewInt(-1)
+		bp, err := NewBalancePolicy(arg)
+		assert.Nil(t, bp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid treasury percent should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.TreasuryPubKeyBytes = []byte("treasury")
+		arg.TreasuryPercent = 100.1
+		bp, err := NewBalancePolicy(arg)
+		assert.Nil(t, bp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("treasury percent without treasury address should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.TreasuryPercent = 10
+		bp, err := NewBalancePolicy(arg)
+		assert.Nil(t, bp)
+		assert.True(t, errors.Is(err, ErrMissingTreasuryAddress))
+	})
+	t.Run("treasury remainder sink without treasury address should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.RemainderSink = core.TreasuryRemainderSink
+		bp, err := NewBalancePolicy(arg)
+		assert.Nil(t, bp)
+		assert.True(t, errors.Is(err, ErrMissingTreasuryAddress))
+	})
+	t.Run("unknown remainder sink should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.RemainderSink = "unknown"
+		bp, err := NewBalancePolicy(arg)
+		assert.Nil(t, bp)
+		assert.True(t, errors.Is(err, ErrUnknownRemainderSink))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bp, err := NewBalancePolicy(createMockArgBalancePolicy())
+		require.Nil(t, err)
+		assert.False(t, bp.IsInterfaceNil())
+		assert.Equal(t, big.NewInt(5), bp.DelegatorBalance())
+	})
+}
+
+func TestBalancePolicy_DistributeBalanceEvenSplit(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := NewBalancePolicy(createMockArgBalancePolicy())
+	owners := createWalletKeys(2, "owner")
+	additionalKeys := createWalletKeys(3, "additional")
+
+	// minimums: 2*10 + 3*1 = 23, left: 104 - 23 = 81 = 5*16 + 1
+	treasury, err := bp.DistributeBalance(big.NewInt(104), owners, additionalKeys)
+	require.Nil(t, err)
+	assert.Nil(t, treasury)
+
+	assert.Equal(t, big.NewInt(27), owners[0].Balance)
+	assert.Equal(t, big.NewInt(26), owners[1].Balance)
+	for _, key := range additionalKeys {
+		assert.Equal(t, big.NewInt(17), key.Balance)
+	}
+}
+
+func TestBalancePolicy_DistributeBalanceRichestAccountMode(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgBalancePolicy()
+	arg.RichestAccountMode = true
+	arg.RemainderSink = core.AdditionalRemainderSink
+	bp, _ := NewBalancePolicy(arg)
+	owners := createWalletKeys(2, "owner")
+	additionalKeys := createWalletKeys(3, "additional")
+
+	treasury, err := bp.DistributeBalance(big.NewInt(104), owners, additionalKeys)
+	require.Nil(t, err)
+	assert.Nil(t, treasury)
+
+	assert.Equal(t, big.NewInt(10), owners[0].Balance)
+	assert.Equal(t, big.NewInt(10), owners[1].Balance)
+	assert.Equal(t, big.NewInt(82), additionalKeys[0].Balance)
+	assert.Equal(t, big.NewInt(1), additionalKeys[1].Balance)
+	assert.Equal(t, big.NewInt(1), additionalKeys[2].Balance)
+}
+
+func TestBalancePolicy_DistributeBalanceWithTreasury(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgBalancePolicy()
+	arg.TreasuryPubKeyBytes = []byte("treasury")
+	arg.TreasuryPercent = 2.5
+	arg.RemainderSink = core.TreasuryRemainderSink
+	bp, _ := NewBalancePolicy(arg)
+	owners := createWalletKeys(2, "owner")
+	additionalKeys := createWalletKeys(3, "additional")
+
+	// treasury: 2.5% of 1000 = 25, minimums: 23, left: 104 - 25 - 23 = 56 = 5*11 + 1
+	treasury, err := bp.DistributeBalance(big.NewInt(104), owners, additionalKeys)
+	require.Nil(t, err)
+	require.NotNil(t, treasury)
+
+	assert.Equal(t, []byte("treasury"), treasury.PubKeyBytes)
+	assert.Equal(t, big.NewInt(26), treasury.Balance)
+	for _, key := range owners {
+		assert.Equal(t, big.NewInt(21), key.Balance)
+	}
+	for _, key := range additionalKeys {
+		assert.Equal(t, big.NewInt(12), key.Balance)
+	}
+}
+
+func TestBalancePolicy_DistributeBalanceNotEnoughBalanceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("treasury exceeds the balance", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgBalancePolicy()
+		arg.TreasuryPubKeyBytes = []byte("treasury")
+		arg.TreasuryPercent = 50
+		bp, _ := NewBalancePolicy(arg)
+
+		treasury, err := bp.DistributeBalance(big.NewInt(104), createWalletKeys(1, "owner"), nil)
+		assert.Nil(t, treasury)
+		assert.True(t, errors.Is(err, ErrTotalSupplyTooSmall))
+	})
+	t.Run("minimums exceed the balance", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := NewBalancePolicy(createMockArgBalancePolicy())
+
+		treasury, err := bp.DistributeBalance(big.NewInt(29), createWalletKeys(3, "owner"), nil)
+		assert.Nil(t, treasury)
+		assert.True(t, errors.Is(err, ErrTotalSupplyTooSmall))
+	})
+}
+
+func TestBalancePolicy_DistributeBalanceMissingRemainderSinkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgBalancePolicy()
+	arg.RemainderSink = core.AdditionalRemainderSink
+	bp, _ := NewBalancePolicy(arg)
+
+	treasury, err := bp.DistributeBalance(big.NewInt(31), createWalletKeys(3, "owner"), nil)
+	assert.Nil(t, treasury)
+	assert.True(t, errors.Is(err, ErrMissingRemainderSink))
+}
@@ -1,10 +1,12 @@
 package generate
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
 	"github.com/multiversx/mx-chain-go/sharding"
 )
 
@@ -13,38 +15,57 @@ type baseGenerator struct {
 	wkg                      *walletKeyGenerator
 	numValidatorBlsKeys      uint
 	numObserverBlsKeys       uint
-	richestAccountMode       bool
+	balancePolicy            BalancePolicy
 	numAdditionalWalletKeys  uint
 	totalSupply              *big.Int
 	walletPubKeyConverter    core.PubkeyConverter
 	validatorPubKeyConverter core.PubkeyConverter
-	initialRating            uint32
+	ratingProfile            RatingProfile
+	importedValidatorBlsKeys []*data.BlsKey
 }
 
-func (bg *baseGenerator) computeWalletBalance(numTotalWalletKeys int, balance *big.Int) (*big.Int, *big.Int) {
-	// walletBalance = balance / numTotalWalletKeys
-	walletBalance := big.NewInt(0).Set(balance)
-	walletBalance.Div(walletBalance, big.NewInt(int64(numTotalWalletKeys)))
-	// remainder = balance % numTotalWalletKeys
-	remainder := big.NewInt(0).Set(balance)
-	remainder.Mod(remainder, big.NewInt(int64(numTotalWalletKeys)))
-
-	shouldReturnComputedWalletBalance := walletBalance.Cmp(minimumInitialBalance) <= 0 || !bg.richestAccountMode
-	if shouldReturnComputedWalletBalance {
-		return walletBalance, remainder
+// appendTreasuryAccount appends the treasury account, if any, to the provided initial accounts
+func (bg *baseGenerator) appendTreasuryAccount(
+	initialAccounts []mxData.InitialAccount,
+	treasury *data.WalletKey,
+) []mxData.InitialAccount {
+	if treasury == nil {
+		return initialAccounts
 	}
 
-	// remainder = balance - (minimumInitialBalance * minimumInitialBalance)
-	remainder = big.NewInt(0).Set(balance)
-	totalWalletBalance := big.NewInt(int64(numTotalWalletKeys))
-	totalWalletBalance.Mul(totalWalletBalance, minimumInitialBalance)
-	remainder.Sub(remainder, totalWalletBalance)
+	treasuryAddress, _ := bg.walletPubKeyConverter.Encode(treasury.PubKeyBytes)
+	account := mxData.InitialAccount{
+		Address:      treasuryAddress,
+		Supply:       big.NewInt(0).Set(treasury.Balance),
+		Balance:      big.NewInt(0).Set(treasury.Balance),
+		StakingValue: big.NewInt(0),
+		Delegation: &mxData.DelegationData{
+			Address: "",
+			Value:   big.NewInt(0),
+		},
+	}
 
-	return minimumInitialBalance, remainder
+	return append(initialAccounts, account)
 }
 
 func (bg *baseGenerator) generateValidatorAndObservers() ([]*data.BlsKey, []*data.BlsKey, error) {
-	validatorBlsKeys, err := bg.vkg.GenerateKeys(bg.numValidatorBlsKeys)
+	numImportedBlsKeys := uint(len(bg.importedValidatorBlsKeys))
+	if numImportedBlsKeys > bg.numValidatorBlsKeys {
+		return nil, nil, fmt.Errorf("%w, imported: %d, number of validators: %d",
+			ErrTooManyImportedBlsKeys, numImportedBlsKeys, bg.numValidatorBlsKeys)
+	}
+
+	// the imported keys will occupy the first validators positions, the rest being generated
+	generatedBlsKeys, err := bg.vkg.GenerateKeys(bg.numValidatorBlsKeys - numImportedBlsKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validatorBlsKeys := make([]*data.BlsKey, 0, bg.numValidatorBlsKeys)
+	validatorBlsKeys = append(validatorBlsKeys, bg.importedValidatorBlsKeys...)
+	validatorBlsKeys = append(validatorBlsKeys, generatedBlsKeys...)
+
+	err = checkDuplicatedBlsKeys(validatorBlsKeys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -57,6 +78,20 @@ func (bg *baseGenerator) generateValidatorAndObservers() ([]*data.BlsKey, []*dat
 	return validatorBlsKeys, observerBlsKeys, nil
 }
 
+func checkDuplicatedBlsKeys(blsKeys []*data.BlsKey) error {
+	existing := make(map[string]struct{}, len(blsKeys))
+	for i, key := range blsKeys {
+		_, found := existing[string(key.PubKeyBytes)]
+		if found {
+			return fmt.Errorf("%w at index %d", ErrDuplicatedBlsKey, i)
+		}
+
+		existing[string(key.PubKeyBytes)] = struct{}{}
+	}
+
+	return nil
+}
+
 func (bg *baseGenerator) computeInitialNodesForWalletKey(key *data.WalletKey) []*sharding.InitialNode {
 	initialNodes := make([]*sharding.InitialNode, 0, len(key.BlsKeys))
 
@@ -67,7 +102,7 @@ func (bg *baseGenerator) computeInitialNodesForWalletKey(key *data.WalletKey) []
 		initialNode := &sharding.InitialNode{
 			PubKey:        validatorPubKey,
 			Address:       walletAddress,
-			InitialRating: bg.initialRating,
+			InitialRating: bg.ratingProfile.ComputeRating(key.PubKeyBytes),
 		}
 		initialNodes = append(initialNodes, initialNode)
 	}
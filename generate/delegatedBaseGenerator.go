@@ -3,17 +3,28 @@ package generate
 import (
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-deploy-go/core"
 	"github.com/multiversx/mx-chain-deploy-go/data"
+	"github.com/multiversx/mx-chain-go/sharding"
 )
 
+type delegationProvider struct {
+	ownerPkBytes          []byte
+	ownerNonce            uint64
+	scPkString            string
+	scPkBytes             []byte
+	numNodes              int
+	numDelegators         int
+	delegatorDistribution DelegatorDistribution
+}
+
 type delegatedBaseGenerator struct {
 	*baseGenerator
-	delegationScPkString string
-	delegationScPkBytes  []byte
-	numDelegators        uint
+	providers []*delegationProvider
+	vmType    string
 }
 
 func checkDelegatedStakingArgument(arg ArgDelegatedStakingGenerator) error {
@@ -23,65 +34,261 @@ func checkDelegatedStakingArgument(arg ArgDelegatedStakingGenerator) error {
 	if check.IfNil(arg.ValidatorPubKeyConverter) {
 		return fmt.Errorf("%w for the ValidatorPubKeyConverter", ErrNilPubKeyConverter)
 	}
-	if arg.NumDelegators == 0 {
-		return fmt.Errorf("%w for the NumDelegators", ErrInvalidValue)
+	if len(arg.DelegationProviders) == 0 {
+		return ErrNoDelegationProviders
+	}
+	for i, provider := range arg.DelegationProviders {
+		if provider.NumDelegators == 0 {
+			return fmt.Errorf("%w for the NumDelegators of the delegation provider at index %d", ErrInvalidValue, i)
+		}
+	}
+	if check.IfNil(arg.DelegatorDistribution) {
+		return ErrNilDelegatorDistribution
+	}
+	if check.IfNil(arg.BalancePolicy) {
+		return ErrNilBalancePolicy
+	}
+	if check.IfNil(arg.RatingProfile) {
+		return ErrNilRatingProfile
 	}
 
 	return nil
 }
 
-func (dbs *delegatedBaseGenerator) prepareFieldsFromArguments(arg ArgDelegatedStakingGenerator, randomizer IntRandomizer) error {
+func (dbs *delegatedBaseGenerator) prepareFieldsFromArguments(arg ArgDelegatedStakingGenerator, numDelegatedNodes int) error {
 	var err error
 	dbs.vkg, err = NewValidatorKeyGenerator(arg.KeyGeneratorForValidators)
 	if err != nil {
 		return err
 	}
 
-	dbs.wkg, err = NewWalletKeyGenerator(arg.KeyGeneratorForWallets, randomizer, arg.NodePrice)
+	dbs.wkg, err = NewWalletKeyGenerator(arg.KeyGeneratorForWallets, arg.NodePrice)
+	if err != nil {
+		return err
+	}
+
+	providersNumNodes, err := computeProvidersNumNodes(arg.DelegationProviders, numDelegatedNodes)
 	if err != nil {
 		return err
 	}
 
-	dbs.delegationScPkString, err = core.GenerateSCAddress(
-		arg.DelegationOwnerPkString,
-		arg.DelegationOwnerNonce,
-		arg.VmType,
-		arg.WalletPubKeyConverter,
-	)
+	dbs.vmType = arg.VmType
+	dbs.providers = make([]*delegationProvider, 0, len(arg.DelegationProviders))
+	scAddresses := make(map[string]struct{})
+	for i, providerArg := range arg.DelegationProviders {
+		ownerPkBytes, errDecode := arg.WalletPubKeyConverter.Decode(providerArg.OwnerPkString)
+		if errDecode != nil {
+			return fmt.Errorf("%w for the owner of the delegation provider at index %d", errDecode, i)
+		}
+
+		scPkString, errGenerate := core.GenerateSCAddress(
+			providerArg.OwnerPkString,
+			providerArg.OwnerNonce,
+			arg.VmType,
+			arg.WalletPubKeyConverter,
+		)
+		if errGenerate != nil {
+			return fmt.Errorf("%w for the delegation provider at index %d", errGenerate, i)
+		}
+
+		_, found := scAddresses[scPkString]
+		if found {
+			return fmt.Errorf("%w %s for the delegation provider at index %d, owner: %s, nonce: %d",
+				ErrDuplicatedDelegationContract, scPkString, i, providerArg.OwnerPkString, providerArg.OwnerNonce)
+		}
+		scAddresses[scPkString] = struct{}{}
+
+		scPkBytes, errDecode := arg.WalletPubKeyConverter.Decode(scPkString)
+		if errDecode != nil {
+			return errDecode
+		}
+
+		dbs.providers = append(dbs.providers, &delegationProvider{
+			ownerPkBytes:  ownerPkBytes,
+			ownerNonce:    providerArg.OwnerNonce,
+			scPkString:    scPkString,
+			scPkBytes:     scPkBytes,
+			numNodes:      providersNumNodes[i],
+			numDelegators: int(providerArg.NumDelegators),
+		})
+	}
+
+	err = dbs.setProvidersDelegatorDistributions(arg.DelegatorDistribution)
 	if err != nil {
 		return err
 	}
 
-	dbs.delegationScPkBytes, err = arg.WalletPubKeyConverter.Decode(dbs.delegationScPkString)
+	return checkDelegationOwnersNonces(arg.DelegationProviders)
+}
+
+// setProvidersDelegatorDistributions gives each delegation provider its delegator distribution: the distributions
+// holding the values of all the delegators are split, in order, among the providers, the other ones are shared
+func (dbs *delegatedBaseGenerator) setProvidersDelegatorDistributions(distribution DelegatorDistribution) error {
+	splitter, ok := distribution.(delegatorDistributionSplitter)
+	if !ok {
+		for _, provider := range dbs.providers {
+			provider.delegatorDistribution = distribution
+		}
+
+		return nil
+	}
+
+	providersNumDelegators := make([]int, 0, len(dbs.providers))
+	for _, provider := range dbs.providers {
+		providersNumDelegators = append(providersNumDelegators, provider.numDelegators)
+	}
+	distributions, err := splitter.SplitForProviders(providersNumDelegators)
 	if err != nil {
 		return err
 	}
+	for i, provider := range dbs.providers {
+		provider.delegatorDistribution = distributions[i]
+	}
 
 	return nil
 }
 
-func (dbs *delegatedBaseGenerator) prepareDelegators(delegators []*data.WalletKey, numDelegated int) *big.Int {
-	// totalDelegated = numDelegated * nodePrice
-	totalDelegated := big.NewInt(int64(numDelegated))
+// checkDelegationOwnersNonces verifies that the nonces of each delegation owner form the 0, 1, 2... sequence as the
+// genesis deploys the delegation contracts of an owner one after the other, starting from nonce 0
+func checkDelegationOwnersNonces(providers []DelegationProvider) error {
+	ownersNonces := make(map[string][]uint64)
+	for _, provider := range providers {
+		ownersNonces[provider.OwnerPkString] = append(ownersNonces[provider.OwnerPkString], provider.OwnerNonce)
+	}
+
+	for owner, nonces := range ownersNonces {
+		sort.Slice(nonces, func(i, j int) bool {
+			return nonces[i] < nonces[j]
+		})
+		for i, nonce := range nonces {
+			if nonce != uint64(i) {
+				return fmt.Errorf("%w for owner %s, expected nonce %d, got %d",
+					ErrInvalidDelegationOwnerNonce, owner, i, nonce)
+			}
+		}
+	}
+
+	return nil
+}
+
+// computeProvidersNumNodes returns the number of nodes of each delegation provider. At most one provider can have
+// NumNodes set to 0, meaning it will receive all the delegated nodes not taken by the other providers
+func computeProvidersNumNodes(providers []DelegationProvider, numDelegatedNodes int) ([]int, error) {
+	numNodes := make([]int, 0, len(providers))
+	remainingNodes := numDelegatedNodes
+	restProviderIndex := -1
+	for i, provider := range providers {
+		if provider.NumNodes == 0 {
+			if restProviderIndex >= 0 {
+				return nil, fmt.Errorf("%w, only one delegation provider can have NumNodes set to 0, found at "+
+					"indexes %d and %d", ErrInvalidNumberOfDelegatedNodes, restProviderIndex, i)
+			}
+			restProviderIndex = i
+		}
+
+		numNodes = append(numNodes, int(provider.NumNodes))
+		remainingNodes -= int(provider.NumNodes)
+	}
+
+	if restProviderIndex >= 0 {
+		numNodes[restProviderIndex] = remainingNodes
+		remainingNodes = 0
+	}
+	if remainingNodes != 0 || (restProviderIndex >= 0 && numNodes[restProviderIndex] < 1) {
+		return nil, fmt.Errorf("%w, the delegation providers hold %d nodes, number of delegated nodes: %d",
+			ErrInvalidNumberOfDelegatedNodes, numDelegatedNodes-remainingNodes, numDelegatedNodes)
+	}
+
+	return numNodes, nil
+}
+
+// generateDelegators will generate the delegators of all the delegation providers, in order, and will return them
+// together with the total value used for delegation and for their balances
+func (dbs *delegatedBaseGenerator) generateDelegators() ([]*data.WalletKey, *big.Int, error) {
+	allDelegators := make([]*data.WalletKey, 0)
+	usedBalance := big.NewInt(0)
+	for i, provider := range dbs.providers {
+		delegators, err := dbs.wkg.GenerateAdditionalKeys(provider.numDelegators)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		providerUsedBalance, err := dbs.prepareDelegators(delegators, provider)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the delegation provider at index %d", err, i)
+		}
+
+		usedBalance.Add(usedBalance, providerUsedBalance)
+		allDelegators = append(allDelegators, delegators...)
+	}
+
+	return allDelegators, usedBalance, nil
+}
+
+func (dbs *delegatedBaseGenerator) prepareDelegators(delegators []*data.WalletKey, provider *delegationProvider) (*big.Int, error) {
+	// totalDelegated = numNodes * nodePrice
+	totalDelegated := big.NewInt(int64(provider.numNodes))
 	totalDelegated.Mul(totalDelegated, dbs.wkg.NodePrice())
-	// delegated = totalDelegated / len(delegators)
-	delegated := big.NewInt(0).Set(totalDelegated)
-	delegated.Div(delegated, big.NewInt(int64(len(delegators))))
-	// remainder = totalDelegated % len(delegators)
-	remainder := big.NewInt(0).Set(totalDelegated)
-	remainder.Mod(remainder, big.NewInt(int64(len(delegators))))
+
+	delegatedValues, err := provider.delegatorDistribution.ComputeDelegatedValues(totalDelegated, len(delegators))
+	if err != nil {
+		return nil, err
+	}
+	if len(delegatedValues) != len(delegators) {
+		return nil, fmt.Errorf("%w, computed: %d, number of delegators: %d",
+			ErrInvalidNumberOfDelegatedValues, len(delegatedValues), len(delegators))
+	}
 
 	for i, wallet := range delegators {
-		wallet.DelegatedPubKeyBytes = make([]byte, len(dbs.delegationScPkBytes))
-		copy(wallet.DelegatedPubKeyBytes, dbs.delegationScPkBytes)
-		wallet.DelegatedValue = big.NewInt(0).Set(delegated)
-		if i == 0 {
-			wallet.DelegatedValue.Add(wallet.DelegatedValue, remainder)
-		}
+		wallet.DelegatedPubKeyBytes = make([]byte, len(provider.scPkBytes))
+		copy(wallet.DelegatedPubKeyBytes, provider.scPkBytes)
+		wallet.DelegatedValue = delegatedValues[i]
 		// give a little balance to each delegator so it can claim the rewards
-		wallet.Balance = minimumInitialBalance
-		totalDelegated.Add(totalDelegated, minimumInitialBalance)
+		wallet.Balance = dbs.balancePolicy.DelegatorBalance()
+		totalDelegated.Add(totalDelegated, wallet.Balance)
 	}
 
-	return totalDelegated
+	return totalDelegated, nil
+}
+
+// computeDelegatedInitialNodes assigns the provided validators, in order, to the delegation providers
+func (dbs *delegatedBaseGenerator) computeDelegatedInitialNodes(validators []*data.BlsKey) []*sharding.InitialNode {
+	initialNodes := make([]*sharding.InitialNode, 0, len(validators))
+
+	index := 0
+	for _, provider := range dbs.providers {
+		for i := 0; i < provider.numNodes; i++ {
+			nodeAddress, _ := dbs.validatorPubKeyConverter.Encode(validators[index].PubKeyBytes)
+			index++
+
+			initialNode := &sharding.InitialNode{
+				PubKey:        nodeAddress,
+				Address:       provider.scPkString,
+				InitialRating: dbs.ratingProfile.ComputeRating(provider.scPkBytes),
+			}
+			initialNodes = append(initialNodes, initialNode)
+		}
+	}
+
+	return initialNodes
+}
+
+// computeDelegationContracts returns the delegation contracts of the providers, ordered by the owner nonce so that the
+// genesis deploys them at the addresses generated from the owners addresses and nonces
+func (dbs *delegatedBaseGenerator) computeDelegationContracts() []*data.DelegationContract {
+	contracts := make([]*data.DelegationContract, 0, len(dbs.providers))
+	for _, provider := range dbs.providers {
+		contracts = append(contracts, &data.DelegationContract{
+			OwnerPubKeyBytes: provider.ownerPkBytes,
+			OwnerNonce:       provider.ownerNonce,
+			ScPubKeyBytes:    provider.scPkBytes,
+			VmType:           dbs.vmType,
+		})
+	}
+
+	sort.SliceStable(contracts, func(i, j int) bool {
+		return contracts[i].OwnerNonce < contracts[j].OwnerNonce
+	})
+
+	return contracts
 }
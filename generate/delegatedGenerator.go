@@ -5,9 +5,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-deploy-go/data"
-	"github.com/multiversx/mx-chain-deploy-go/generate/disabled"
 	mxData "github.com/multiversx/mx-chain-go/genesis/data"
-	"github.com/multiversx/mx-chain-go/sharding"
 )
 
 type delegatedStakingGenerator struct {
@@ -26,17 +24,18 @@ func NewDelegatedGenerator(arg ArgDelegatedStakingGenerator) (*delegatedStakingG
 			baseGenerator: &baseGenerator{
 				numValidatorBlsKeys:      arg.NumValidatorBlsKeys,
 				numObserverBlsKeys:       arg.NumObserverBlsKeys,
-				richestAccountMode:       arg.RichestAccountMode,
+				balancePolicy:            arg.BalancePolicy,
 				numAdditionalWalletKeys:  arg.NumAdditionalWalletKeys,
 				totalSupply:              arg.TotalSupply,
 				walletPubKeyConverter:    arg.WalletPubKeyConverter,
 				validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+				ratingProfile:            arg.RatingProfile,
+				importedValidatorBlsKeys: arg.ImportedValidatorBlsKeys,
 			},
-			numDelegators: arg.NumDelegators,
 		},
 	}
 
-	err = dsg.prepareFieldsFromArguments(arg, &disabled.NilRandomizer{})
+	err = dsg.prepareFieldsFromArguments(arg, int(arg.NumValidatorBlsKeys))
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +55,7 @@ func (dsg *delegatedStakingGenerator) Generate() (*data.GeneratorOutput, error)
 		return nil, err
 	}
 
-	delegators, err := dsg.wkg.GenerateAdditionalKeys(int(dsg.numDelegators))
+	delegators, usedBalance, err := dsg.generateDelegators()
 	if err != nil {
 		return nil, err
 	}
@@ -70,34 +69,28 @@ func (dsg *delegatedStakingGenerator) Generate() (*data.GeneratorOutput, error)
 		return nil, ErrInvalidNumberOfWalletKeys
 	}
 
-	usedBalance := dsg.prepareDelegators(delegators, len(validatorBlsKeys))
 	balance := big.NewInt(0).Sub(dsg.totalSupply, usedBalance)
 	if balance.Cmp(zero) < 0 {
 		return nil, fmt.Errorf("%w, total supply: %s, usedBalance: %s", ErrTotalSupplyTooSmall,
 			dsg.totalSupply.String(), usedBalance.String())
 	}
 
-	walletBalance, remainder := dsg.computeWalletBalance(len(walletKeys)+len(additionalKeys), balance)
-	for i, key := range walletKeys {
-		key.Balance = big.NewInt(0).Set(walletBalance)
-		if i == 0 {
-			key.Balance.Add(key.Balance, remainder)
-		}
-	}
-
-	for _, key := range additionalKeys {
-		key.Balance = big.NewInt(0).Set(walletBalance)
+	treasury, err := dsg.balancePolicy.DistributeBalance(balance, walletKeys, additionalKeys)
+	if err != nil {
+		return nil, err
 	}
 
 	gen := &data.GeneratorOutput{
-		ValidatorBlsKeys: validatorBlsKeys,
-		ObserverBlsKeys:  observerBlsKeys,
-		WalletKeys:       walletKeys,
-		AdditionalKeys:   additionalKeys,
-		DelegatorKeys:    delegators,
+		ValidatorBlsKeys:    validatorBlsKeys,
+		ObserverBlsKeys:     observerBlsKeys,
+		WalletKeys:          walletKeys,
+		AdditionalKeys:      additionalKeys,
+		DelegatorKeys:       delegators,
+		DelegationContracts: dsg.computeDelegationContracts(),
 	}
 	gen.InitialAccounts = dsg.computeInitialAccounts(walletKeys, additionalKeys, delegators)
-	gen.InitialNodes = dsg.computeInitialNodes(validatorBlsKeys)
+	gen.InitialAccounts = dsg.appendTreasuryAccount(gen.InitialAccounts, treasury)
+	gen.InitialNodes = dsg.computeDelegatedInitialNodes(validatorBlsKeys)
 
 	return gen, nil
 }
@@ -164,23 +157,6 @@ func (dsg *delegatedStakingGenerator) computeInitialAccounts(
 	return initialAccounts
 }
 
-func (dsg *delegatedStakingGenerator) computeInitialNodes(validators []*data.BlsKey) []*sharding.InitialNode {
-	initialNodes := make([]*sharding.InitialNode, 0, len(validators))
-
-	for _, blsKey := range validators {
-		validatorAddress, _ := dsg.validatorPubKeyConverter.Encode(blsKey.PubKeyBytes)
-
-		initialNode := &sharding.InitialNode{
-			PubKey:        validatorAddress,
-			Address:       dsg.delegationScPkString,
-			InitialRating: dsg.initialRating,
-		}
-		initialNodes = append(initialNodes, initialNode)
-	}
-
-	return initialNodes
-}
-
 // IsInterfaceNil returns true if there is no value under the interface
 func (dsg *delegatedStakingGenerator) IsInterfaceNil() bool {
 	return dsg == nil
@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-deploy-go/check"
+	"github.com/multiversx/mx-chain-deploy-go/core"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,14 +24,20 @@ func createMockDelegatedStakingGeneratorArguments() ArgDelegatedStakingGenerator
 		KeyGeneratorForWallets:    signing.NewKeyGenerator(edSuite),
 		NumValidatorBlsKeys:       0,
 		NumObserverBlsKeys:        0,
-		RichestAccountMode:        false,
+		BalancePolicy:             createTestBalancePolicy(testMinimumBalance, false),
 		NumAdditionalWalletKeys:   0,
-		InitialRating:             50,
-		DelegationOwnerPkString:   "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
-		DelegationOwnerNonce:      0,
+		RatingProfile:             createTestRatingProfile(50),
 		VmType:                    "0500",
-		NumDelegators:             0,
+		DelegationProviders: []DelegationProvider{
+			{
+				OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+				OwnerNonce:    0,
+				NumNodes:      0,
+				NumDelegators: 0,
+			},
+		},
 	}
+	arg.DelegatorDistribution, _ = NewEqualDelegatorDistribution(big.NewInt(0))
 	arg.WalletPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
 	arg.ValidatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(96)
 	arg.TotalSupply = big.NewInt(0)
@@ -46,7 +54,7 @@ func TestDelegatedStakingGenerator_GenerateShouldWork(t *testing.T) {
 	arg := createMockDelegatedStakingGeneratorArguments()
 	arg.NumValidatorBlsKeys = 33
 	arg.NumObserverBlsKeys = 3
-	arg.NumDelegators = 47
+	arg.DelegationProviders[0].NumDelegators = 47
 	arg.NumAdditionalWalletKeys = 3
 
 	dsg, err := NewDelegatedGenerator(arg)
@@ -57,15 +65,22 @@ func TestDelegatedStakingGenerator_GenerateShouldWork(t *testing.T) {
 
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.ValidatorBlsKeys))
 	assert.Equal(t, int(arg.NumObserverBlsKeys), len(generatedOutput.ObserverBlsKeys))
-	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.NumDelegators
+	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.DelegationProviders[0].NumDelegators
 	assert.Equal(t, int(expectedNumInitialAccounts), len(generatedOutput.InitialAccounts))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.WalletKeys))
 	assert.Equal(t, int(arg.NumAdditionalWalletKeys), len(generatedOutput.AdditionalKeys))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
-	assert.Equal(t, int(arg.NumDelegators), len(generatedOutput.DelegatorKeys))
+	assert.Equal(t, int(arg.DelegationProviders[0].NumDelegators), len(generatedOutput.DelegatorKeys))
 
-	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply)
-	assert.Nil(t, err, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+
+	goc, _ := check.NewGeneratorOutputChecker(check.ArgGeneratorOutputChecker{
+		NodePrice:                arg.NodePrice,
+		WalletPubKeyConverter:    arg.WalletPubKeyConverter,
+		ValidatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+	})
+	assert.Nil(t, goc.CheckGeneratorOutput(generatedOutput))
 }
 
 func TestDelegatedStakingGenerator_GenerateWithRichestAccountShouldWork(t *testing.T) {
@@ -74,9 +89,9 @@ func TestDelegatedStakingGenerator_GenerateWithRichestAccountShouldWork(t *testi
 	arg := createMockDelegatedStakingGeneratorArguments()
 	arg.NumValidatorBlsKeys = 33
 	arg.NumObserverBlsKeys = 3
-	arg.NumDelegators = 47
+	arg.DelegationProviders[0].NumDelegators = 47
 	arg.NumAdditionalWalletKeys = 3
-	arg.RichestAccountMode = true
+	arg.BalancePolicy = createTestBalancePolicy(testMinimumBalance, true)
 
 	dsg, err := NewDelegatedGenerator(arg)
 	require.Nil(t, err)
@@ -86,20 +101,388 @@ func TestDelegatedStakingGenerator_GenerateWithRichestAccountShouldWork(t *testi
 
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.ValidatorBlsKeys))
 	assert.Equal(t, int(arg.NumObserverBlsKeys), len(generatedOutput.ObserverBlsKeys))
-	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.NumDelegators
+	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.DelegationProviders[0].NumDelegators
 	assert.Equal(t, int(expectedNumInitialAccounts), len(generatedOutput.InitialAccounts))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.WalletKeys))
 	assert.Equal(t, int(arg.NumAdditionalWalletKeys), len(generatedOutput.AdditionalKeys))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
-	assert.Equal(t, int(arg.NumDelegators), len(generatedOutput.DelegatorKeys))
+	assert.Equal(t, int(arg.DelegationProviders[0].NumDelegators), len(generatedOutput.DelegatorKeys))
 
-	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply)
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
 	assert.Nil(t, err, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
 	for i, ia := range generatedOutput.InitialAccounts {
-		if i == int(arg.NumDelegators) {
-			assert.NotEqual(t, minimumInitialBalance, ia.Balance)
+		if i == int(arg.DelegationProviders[0].NumDelegators) {
+			assert.NotEqual(t, testMinimumBalance, ia.Balance)
+		} else {
+			assert.Equal(t, testMinimumBalance, ia.Balance)
+		}
+	}
+}
+
+func TestDelegatedStakingGenerator_GenerateWithParetoDistributionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.DelegationProviders[0].NumDelegators = 200
+	minDelegation, _ := big.NewInt(0).SetString("1000000000000000000", 10)
+	arg.DelegatorDistribution, _ = NewParetoDelegatorDistribution(minDelegation, 1.16, createSequenceRandomizer())
+
+	dsg, err := NewDelegatedGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	require.Nil(t, err)
+
+	totalDelegated := big.NewInt(0)
+	for _, key := range generatedOutput.DelegatorKeys {
+		assert.True(t, key.DelegatedValue.Cmp(minDelegation) >= 0)
+		totalDelegated.Add(totalDelegated, key.DelegatedValue)
+	}
+	expectedTotalDelegated := big.NewInt(0).Mul(arg.NodePrice, big.NewInt(int64(arg.NumValidatorBlsKeys)))
+	assert.Equal(t, expectedTotalDelegated, totalDelegated)
+
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+}
+
+func TestDelegatedStakingGenerator_GenerateWithMinimumDelegationNotMetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 1
+	arg.DelegationProviders[0].NumDelegators = 2
+	arg.DelegatorDistribution, _ = NewEqualDelegatorDistribution(arg.NodePrice)
+
+	dsg, err := NewDelegatedGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	assert.Nil(t, generatedOutput)
+	assert.True(t, errors.Is(err, ErrMinimumDelegationNotMet))
+}
+
+func TestDelegatedStakingGenerator_GenerateWithMultipleProvidersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.DelegationProviders = []DelegationProvider{
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    0,
+			NumNodes:      3,
+			NumDelegators: 5,
+		},
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    1,
+			NumNodes:      0,
+			NumDelegators: 7,
+		},
+	}
+
+	dsg, err := NewDelegatedGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	require.Nil(t, err)
+
+	firstScAddress, _ := core.GenerateSCAddress(arg.DelegationProviders[0].OwnerPkString, 0, arg.VmType, arg.WalletPubKeyConverter)
+	secondScAddress, _ := core.GenerateSCAddress(arg.DelegationProviders[1].OwnerPkString, 1, arg.VmType, arg.WalletPubKeyConverter)
+	require.NotEqual(t, firstScAddress, secondScAddress)
+
+	require.Equal(t, 10, len(generatedOutput.InitialNodes))
+	for i, node := range generatedOutput.InitialNodes {
+		if i < 3 {
+			assert.Equal(t, firstScAddress, node.Address)
+		} else {
+			assert.Equal(t, secondScAddress, node.Address)
+		}
+	}
+
+	require.Equal(t, 12, len(generatedOutput.DelegatorKeys))
+	delegatedValues := map[string]*big.Int{
+		firstScAddress:  big.NewInt(0),
+		secondScAddress: big.NewInt(0),
+	}
+	for i, ia := range generatedOutput.InitialAccounts[:12] {
+		if i < 5 {
+			assert.Equal(t, firstScAddress, ia.Delegation.Address)
 		} else {
-			assert.Equal(t, minimumInitialBalance, ia.Balance)
+			assert.Equal(t, secondScAddress, ia.Delegation.Address)
 		}
+		delegatedValues[ia.Delegation.Address].Add(delegatedValues[ia.Delegation.Address], ia.Delegation.Value)
+	}
+	assert.Equal(t, big.NewInt(0).Mul(arg.NodePrice, big.NewInt(3)), delegatedValues[firstScAddress])
+	assert.Equal(t, big.NewInt(0).Mul(arg.NodePrice, big.NewInt(7)), delegatedValues[secondScAddress])
+
+	require.Equal(t, 2, len(generatedOutput.DelegationContracts))
+	ownerPkBytes, _ := arg.WalletPubKeyConverter.Decode(arg.DelegationProviders[0].OwnerPkString)
+	for i, scAddress := range []string{firstScAddress, secondScAddress} {
+		contract := generatedOutput.DelegationContracts[i]
+		contractAddress, _ := arg.WalletPubKeyConverter.Encode(contract.ScPubKeyBytes)
+		assert.Equal(t, scAddress, contractAddress)
+		assert.Equal(t, ownerPkBytes, contract.OwnerPubKeyBytes)
+		assert.Equal(t, uint64(i), contract.OwnerNonce)
+		assert.Equal(t, arg.VmType, contract.VmType)
+	}
+
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+}
+
+func TestDelegatedStakingGenerator_GenerateWithProvidersOfDifferentSizesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 7
+	arg.DelegationProviders = []DelegationProvider{
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    0,
+			NumNodes:      2,
+			NumDelegators: 3,
+		},
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    1,
+			NumNodes:      5,
+			NumDelegators: 6,
+		},
+	}
+	providersNumDelegators := []int{3, 6}
+	providersTotalDelegated := []*big.Int{
+		big.NewInt(0).Mul(arg.NodePrice, big.NewInt(2)),
+		big.NewInt(0).Mul(arg.NodePrice, big.NewInt(5)),
+	}
+
+	// the list holds the 3 values of the first provider (2 node prices), then the 6 values of the second one
+	// (5 node prices)
+	halfNodePrice := big.NewInt(0).Div(arg.NodePrice, big.NewInt(2))
+	listValues := []*big.Int{
+		arg.NodePrice, halfNodePrice, halfNodePrice,
+		arg.NodePrice, arg.NodePrice, arg.NodePrice, arg.NodePrice, halfNodePrice, halfNodePrice,
+	}
+
+	equalDistribution, err := NewEqualDelegatorDistribution(big.NewInt(0))
+	require.Nil(t, err)
+	randomDistribution, err := NewRandomDelegatorDistribution(big.NewInt(0), arg.NodePrice, createSequenceRandomizer())
+	require.Nil(t, err)
+	listDistribution, err := NewListDelegatorDistribution(listValues, big.NewInt(0))
+	require.Nil(t, err)
+
+	distributions := map[string]DelegatorDistribution{
+		"equal":  equalDistribution,
+		"random": randomDistribution,
+		"list":   listDistribution,
 	}
+	for name, distribution := range distributions {
+		localArg := arg
+		localArg.DelegatorDistribution = distribution
+
+		t.Run(name, func(t *testing.T) {
+			dsg, errCreate := NewDelegatedGenerator(localArg)
+			require.Nil(t, errCreate)
+
+			generatedOutput, errGenerate := dsg.Generate()
+			require.Nil(t, errGenerate)
+			require.Equal(t, 9, len(generatedOutput.DelegatorKeys))
+
+			index := 0
+			for i, provider := range dsg.providers {
+				delegatedValues := make([]*big.Int, 0, providersNumDelegators[i])
+				for _, ia := range generatedOutput.InitialAccounts[index : index+providersNumDelegators[i]] {
+					assert.Equal(t, provider.scPkString, ia.Delegation.Address)
+					delegatedValues = append(delegatedValues, ia.Delegation.Value)
+				}
+				index += providersNumDelegators[i]
+
+				assert.Equal(t, providersTotalDelegated[i], sumDelegatedValues(delegatedValues))
+				if name == "list" {
+					assert.Equal(t, listValues[index-providersNumDelegators[i]:index], delegatedValues)
+				}
+			}
+
+			iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+			assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+		})
+	}
+}
+
+func TestDelegatedStakingGenerator_ListDistributionNotMatchingTheProvidersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 3
+	arg.DelegationProviders = []DelegationProvider{
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    0,
+			NumNodes:      1,
+			NumDelegators: 1,
+		},
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    1,
+			NumNodes:      2,
+			NumDelegators: 2,
+		},
+	}
+
+	t.Run("list not covering all the delegators should error", func(t *testing.T) {
+		t.Parallel()
+
+		localArg := arg
+		localArg.DelegatorDistribution, _ = NewListDelegatorDistribution(
+			[]*big.Int{arg.NodePrice, arg.NodePrice}, big.NewInt(0))
+
+		dsg, err := NewDelegatedGenerator(localArg)
+		assert.Nil(t, dsg)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfDelegatedValues))
+	})
+	t.Run("slice not matching the provider nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		// the whole list sums up to 3 node prices, but the first provider receives 2 node prices for 1 node
+		localArg := arg
+		twoNodesPrice := big.NewInt(0).Mul(arg.NodePrice, big.NewInt(2))
+		localArg.DelegatorDistribution, _ = NewListDelegatorDistribution(
+			[]*big.Int{twoNodesPrice, big.NewInt(0), arg.NodePrice}, big.NewInt(0))
+
+		dsg, err := NewDelegatedGenerator(localArg)
+		require.Nil(t, err)
+
+		generatedOutput, err := dsg.Generate()
+		assert.Nil(t, generatedOutput)
+		assert.True(t, errors.Is(err, ErrTotalDelegatedMismatch))
+		assert.Contains(t, err.Error(), "delegation provider at index 0")
+	})
+}
+
+func TestDelegatedStakingGenerator_GenerateWithRatingOverridesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.DelegationProviders = []DelegationProvider{
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    0,
+			NumNodes:      4,
+			NumDelegators: 5,
+		},
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    1,
+			NumNodes:      0,
+			NumDelegators: 5,
+		},
+	}
+	secondScAddress, _ := core.GenerateSCAddress(arg.DelegationProviders[1].OwnerPkString, 1, arg.VmType, arg.WalletPubKeyConverter)
+	secondScPkBytes, _ := arg.WalletPubKeyConverter.Decode(secondScAddress)
+	arg.RatingProfile, _ = NewOwnerOverridesRatingProfile(
+		createTestRatingProfile(50),
+		map[string]uint32{string(secondScPkBytes): 7},
+	)
+
+	dsg, err := NewDelegatedGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	require.Nil(t, err)
+
+	require.Equal(t, 10, len(generatedOutput.InitialNodes))
+	for i, node := range generatedOutput.InitialNodes {
+		if i < 4 {
+			assert.Equal(t, uint32(50), node.InitialRating)
+		} else {
+			assert.Equal(t, uint32(7), node.InitialRating)
+		}
+	}
+}
+
+func TestDelegatedStakingGenerator_NilRatingProfileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.DelegationProviders[0].NumDelegators = 1
+	arg.RatingProfile = nil
+
+	dsg, err := NewDelegatedGenerator(arg)
+	assert.Nil(t, dsg)
+	assert.Equal(t, ErrNilRatingProfile, err)
+}
+
+func TestDelegatedStakingGenerator_DuplicatedDelegationContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.DelegationProviders[0].NumDelegators = 1
+	arg.DelegationProviders[0].NumNodes = 5
+	arg.DelegationProviders = append(arg.DelegationProviders, arg.DelegationProviders[0])
+
+	dsg, err := NewDelegatedGenerator(arg)
+	assert.Nil(t, dsg)
+	assert.True(t, errors.Is(err, ErrDuplicatedDelegationContract))
+}
+
+func TestDelegatedStakingGenerator_InvalidDelegationOwnerNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDelegatedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.DelegationProviders[0].NumDelegators = 1
+	arg.DelegationProviders[0].NumNodes = 5
+	second := arg.DelegationProviders[0]
+	second.OwnerNonce = 2
+	arg.DelegationProviders = append(arg.DelegationProviders, second)
+
+	dsg, err := NewDelegatedGenerator(arg)
+	assert.Nil(t, dsg)
+	assert.True(t, errors.Is(err, ErrInvalidDelegationOwnerNonce))
+}
+
+func TestDelegatedStakingGenerator_InvalidNumberOfDelegatedNodesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("two providers taking the rest of the nodes", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegatedStakingGeneratorArguments()
+		arg.NumValidatorBlsKeys = 10
+		arg.DelegationProviders[0].NumDelegators = 1
+		second := arg.DelegationProviders[0]
+		second.OwnerNonce = 1
+		arg.DelegationProviders = append(arg.DelegationProviders, second)
+
+		dsg, err := NewDelegatedGenerator(arg)
+		assert.Nil(t, dsg)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfDelegatedNodes))
+	})
+	t.Run("providers hold more nodes than available", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegatedStakingGeneratorArguments()
+		arg.NumValidatorBlsKeys = 10
+		arg.DelegationProviders[0].NumDelegators = 1
+		arg.DelegationProviders[0].NumNodes = 11
+
+		dsg, err := NewDelegatedGenerator(arg)
+		assert.Nil(t, dsg)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfDelegatedNodes))
+	})
+	t.Run("providers hold less nodes than available", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockDelegatedStakingGeneratorArguments()
+		arg.NumValidatorBlsKeys = 10
+		arg.DelegationProviders[0].NumDelegators = 1
+		arg.DelegationProviders[0].NumNodes = 9
+
+		dsg, err := NewDelegatedGenerator(arg)
+		assert.Nil(t, dsg)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfDelegatedNodes))
+	})
 }
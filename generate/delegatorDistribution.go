@@ -0,0 +1,329 @@
+package generate
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// randomWeightsScale is the number of distinct random weights used by the random and Pareto delegator distributions
+const randomWeightsScale = 1000000
+
+type baseDelegatorDistribution struct {
+	minDelegation *big.Int
+}
+
+func newBaseDelegatorDistribution(minDelegation *big.Int) (*baseDelegatorDistribution, error) {
+	if minDelegation == nil {
+		return nil, ErrNilMinimumDelegation
+	}
+	if minDelegation.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w for the minimum delegation", ErrInvalidValue)
+	}
+
+	return &baseDelegatorDistribution{
+		minDelegation: minDelegation,
+	}, nil
+}
+
+// computeDistributableValue returns the value left after each delegator received the minimum delegation
+func (bdd *baseDelegatorDistribution) computeDistributableValue(totalDelegated *big.Int, numDelegators int) (*big.Int, error) {
+	if numDelegators < 1 {
+		return nil, fmt.Errorf("%w for the number of delegators", ErrInvalidValue)
+	}
+
+	distributable := big.NewInt(0).Mul(bdd.minDelegation, big.NewInt(int64(numDelegators)))
+	distributable.Sub(totalDelegated, distributable)
+	if distributable.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w, total delegated: %s, number of delegators: %d, minimum delegation: %s",
+			ErrMinimumDelegationNotMet, totalDelegated.String(), numDelegators, bdd.minDelegation.String())
+	}
+
+	return distributable, nil
+}
+
+// distributeProportionally gives each delegator the minimum delegation plus a part of the distributable value,
+// proportional with its weight. The rounding remainder is added to the first delegator
+func (bdd *baseDelegatorDistribution) distributeProportionally(distributable *big.Int, weights []*big.Int) []*big.Int {
+	totalWeight := big.NewInt(0)
+	for _, weight := range weights {
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	values := make([]*big.Int, 0, len(weights))
+	remainder := big.NewInt(0).Set(distributable)
+	for _, weight := range weights {
+		share := big.NewInt(0).Mul(distributable, weight)
+		share.Div(share, totalWeight)
+		remainder.Sub(remainder, share)
+
+		values = append(values, share.Add(share, bdd.minDelegation))
+	}
+	values[0].Add(values[0], remainder)
+
+	return values
+}
+
+type equalDelegatorDistribution struct {
+	*baseDelegatorDistribution
+}
+
+// NewEqualDelegatorDistribution will create a delegator distribution in which all delegators delegate the same value.
+// The division remainder is delegated by the first delegator
+func NewEqualDelegatorDistribution(minDelegation *big.Int) (*equalDelegatorDistribution, error) {
+	base, err := newBaseDelegatorDistribution(minDelegation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &equalDelegatorDistribution{
+		baseDelegatorDistribution: base,
+	}, nil
+}
+
+// ComputeDelegatedValues returns the value delegated by each delegator
+func (edd *equalDelegatorDistribution) ComputeDelegatedValues(totalDelegated *big.Int, numDelegators int) ([]*big.Int, error) {
+	distributable, err := edd.computeDistributableValue(totalDelegated, numDelegators)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := make([]*big.Int, 0, numDelegators)
+	for i := 0; i < numDelegators; i++ {
+		weights = append(weights, big.NewInt(1))
+	}
+
+	return edd.distributeProportionally(distributable, weights), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (edd *equalDelegatorDistribution) IsInterfaceNil() bool {
+	return edd == nil
+}
+
+type randomDelegatorDistribution struct {
+	*baseDelegatorDistribution
+	maxDelegation *big.Int
+	randomizer    IntRandomizer
+}
+
+// NewRandomDelegatorDistribution will create a delegator distribution in which each delegator delegates a random value
+// in the [minDelegation, maxDelegation] interval
+func NewRandomDelegatorDistribution(
+	minDelegation *big.Int,
+	maxDelegation *big.Int,
+	randomizer IntRandomizer,
+) (*randomDelegatorDistribution, error) {
+	base, err := newBaseDelegatorDistribution(minDelegation)
+	if err != nil {
+		return nil, err
+	}
+	if maxDelegation == nil || maxDelegation.Cmp(minDelegation) < 0 {
+		return nil, fmt.Errorf("%w for the maximum delegation", ErrInvalidValue)
+	}
+	if check.IfNil(randomizer) {
+		return nil, ErrNilRandomizer
+	}
+
+	return &randomDelegatorDistribution{
+		baseDelegatorDistribution: base,
+		maxDelegation:             maxDelegation,
+		randomizer:                randomizer,
+	}, nil
+}
+
+// ComputeDelegatedValues returns the value delegated by each delegator
+func (rdd *randomDelegatorDistribution) ComputeDelegatedValues(totalDelegated *big.Int, numDelegators int) ([]*big.Int, error) {
+	distributable, err := rdd.computeDistributableValue(totalDelegated, numDelegators)
+	if err != nil {
+		return nil, err
+	}
+
+	// capacity = maxDelegation - minDelegation is the maximum value each delegator can receive on top of the minimum
+	capacity := big.NewInt(0).Sub(rdd.maxDelegation, rdd.minDelegation)
+	totalCapacity := big.NewInt(0).Mul(capacity, big.NewInt(int64(numDelegators)))
+	if distributable.Cmp(totalCapacity) > 0 {
+		return nil, fmt.Errorf("%w, total delegated: %s, number of delegators: %d, maximum delegation: %s",
+			ErrMaximumDelegationExceeded, totalDelegated.String(), numDelegators, rdd.maxDelegation.String())
+	}
+
+	weights := make([]*big.Int, 0, numDelegators)
+	totalWeight := big.NewInt(0)
+	for i := 0; i < numDelegators; i++ {
+		weight := big.NewInt(int64(rdd.randomizer.Intn(randomWeightsScale) + 1))
+		totalWeight.Add(totalWeight, weight)
+		weights = append(weights, weight)
+	}
+
+	shares := make([]*big.Int, 0, numDelegators)
+	leftover := big.NewInt(0).Set(distributable)
+	for _, weight := range weights {
+		share := big.NewInt(0).Mul(distributable, weight)
+		share.Div(share, totalWeight)
+		if share.Cmp(capacity) > 0 {
+			share.Set(capacity)
+		}
+
+		leftover.Sub(leftover, share)
+		shares = append(shares, share)
+	}
+
+	// the value left because of the capping and rounding is given to the delegators that did not reach the maximum
+	for _, share := range shares {
+		if leftover.Cmp(zero) == 0 {
+			break
+		}
+
+		available := big.NewInt(0).Sub(capacity, share)
+		if available.Cmp(leftover) > 0 {
+			available.Set(leftover)
+		}
+		share.Add(share, available)
+		leftover.Sub(leftover, available)
+	}
+
+	values := make([]*big.Int, 0, numDelegators)
+	for _, share := range shares {
+		values = append(values, share.Add(share, rdd.minDelegation))
+	}
+
+	return values, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rdd *randomDelegatorDistribution) IsInterfaceNil() bool {
+	return rdd == nil
+}
+
+type paretoDelegatorDistribution struct {
+	*baseDelegatorDistribution
+	alpha      float64
+	randomizer IntRandomizer
+}
+
+// NewParetoDelegatorDistribution will create a delegator distribution in which the delegated values, on top of the
+// minimum delegation, follow a Pareto distribution with the provided shape (alpha). The lower the alpha, the heavier
+// the tail: a few whales and a lot of small delegators
+func NewParetoDelegatorDistribution(
+	minDelegation *big.Int,
+	alpha float64,
+	randomizer IntRandomizer,
+) (*paretoDelegatorDistribution, error) {
+	base, err := newBaseDelegatorDistribution(minDelegation)
+	if err != nil {
+		return nil, err
+	}
+	if alpha <= 0 || math.IsInf(alpha, 0) || math.IsNaN(alpha) {
+		return nil, fmt.Errorf("%w for alpha", ErrInvalidValue)
+	}
+	if check.IfNil(randomizer) {
+		return nil, ErrNilRandomizer
+	}
+
+	return &paretoDelegatorDistribution{
+		baseDelegatorDistribution: base,
+		alpha:                     alpha,
+		randomizer:                randomizer,
+	}, nil
+}
+
+// ComputeDelegatedValues returns the value delegated by each delegator
+func (pdd *paretoDelegatorDistribution) ComputeDelegatedValues(totalDelegated *big.Int, numDelegators int) ([]*big.Int, error) {
+	distributable, err := pdd.computeDistributableValue(totalDelegated, numDelegators)
+	if err != nil {
+		return nil, err
+	}
+
+	// inverse transform sampling: weight = 1 / u^(1/alpha), u being uniform in (0, 1]
+	weights := make([]*big.Int, 0, numDelegators)
+	for i := 0; i < numDelegators; i++ {
+		u := float64(pdd.randomizer.Intn(randomWeightsScale)+1) / randomWeightsScale
+		weight, _ := big.NewFloat(randomWeightsScale / math.Pow(u, 1/pdd.alpha)).Int(nil)
+		weights = append(weights, weight)
+	}
+
+	return pdd.distributeProportionally(distributable, weights), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pdd *paretoDelegatorDistribution) IsInterfaceNil() bool {
+	return pdd == nil
+}
+
+type listDelegatorDistribution struct {
+	values []*big.Int
+}
+
+// NewListDelegatorDistribution will create a delegator distribution in which the delegated values are explicitly
+// provided. Each value should be at least the minimum delegation
+func NewListDelegatorDistribution(values []*big.Int, minDelegation *big.Int) (*listDelegatorDistribution, error) {
+	if minDelegation == nil {
+		return nil, ErrNilMinimumDelegation
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w, empty delegated values list", ErrInvalidValue)
+	}
+	for i, value := range values {
+		if value == nil || value.Cmp(minDelegation) < 0 {
+			return nil, fmt.Errorf("%w for the value at index %d, minimum delegation: %s",
+				ErrMinimumDelegationNotMet, i, minDelegation.String())
+		}
+	}
+
+	return &listDelegatorDistribution{
+		values: values,
+	}, nil
+}
+
+// ComputeDelegatedValues returns a copy of the provided values, after checking that they match the number of
+// delegators and sum up to the total delegated value
+func (ldd *listDelegatorDistribution) ComputeDelegatedValues(totalDelegated *big.Int, numDelegators int) ([]*big.Int, error) {
+	if len(ldd.values) != numDelegators {
+		return nil, fmt.Errorf("%w, provided: %d, number of delegators: %d",
+			ErrInvalidNumberOfDelegatedValues, len(ldd.values), numDelegators)
+	}
+
+	values := make([]*big.Int, 0, len(ldd.values))
+	sum := big.NewInt(0)
+	for _, value := range ldd.values {
+		sum.Add(sum, value)
+		values = append(values, big.NewInt(0).Set(value))
+	}
+	if sum.Cmp(totalDelegated) != 0 {
+		return nil, fmt.Errorf("%w, computed: %s, expected: %s", ErrTotalDelegatedMismatch, sum.String(), totalDelegated.String())
+	}
+
+	return values, nil
+}
+
+// SplitForProviders splits the provided values, in order, among the delegation providers: the first provider
+// receives the first values, as many as its delegators, the second provider the next ones and so on. All the values
+// have to be used
+func (ldd *listDelegatorDistribution) SplitForProviders(providersNumDelegators []int) ([]DelegatorDistribution, error) {
+	totalNumDelegators := 0
+	for _, numDelegators := range providersNumDelegators {
+		totalNumDelegators += numDelegators
+	}
+	if len(ldd.values) != totalNumDelegators {
+		return nil, fmt.Errorf("%w, provided: %d, number of delegators of all the delegation providers: %d",
+			ErrInvalidNumberOfDelegatedValues, len(ldd.values), totalNumDelegators)
+	}
+
+	distributions := make([]DelegatorDistribution, 0, len(providersNumDelegators))
+	index := 0
+	for _, numDelegators := range providersNumDelegators {
+		distributions = append(distributions, &listDelegatorDistribution{
+			values: ldd.values[index : index+numDelegators],
+		})
+		index += numDelegators
+	}
+
+	return distributions, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ldd *listDelegatorDistribution) IsInterfaceNil() bool {
+	return ldd == nil
+}
@@ -0,0 +1,255 @@
+package generate
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sumDelegatedValues(values []*big.Int) *big.Int {
+	sum := big.NewInt(0)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+
+	return sum
+}
+
+func createSequenceRandomizer() *mock.IntRandomizerStub {
+	counter := 0
+	return &mock.IntRandomizerStub{
+		IntnCalled: func(n int) int {
+			counter = (counter + 7919) % n
+			return counter
+		},
+	}
+}
+
+func TestNewEqualDelegatorDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil minimum delegation should error", func(t *testing.T) {
+		t.Parallel()
+
+		edd, err := NewEqualDelegatorDistribution(nil)
+		assert.Nil(t, edd)
+		assert.Equal(t, ErrNilMinimumDelegation, err)
+	})
+	t.Run("negative minimum delegation should error", func(t *testing.T) {
+		t.Parallel()
+
+		edd, err := NewEqualDelegatorDistribution(big.NewInt(-1))
+		assert.Nil(t, edd)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("minimum delegation not met should error", func(t *testing.T) {
+		t.Parallel()
+
+		edd, _ := NewEqualDelegatorDistribution(big.NewInt(10))
+		values, err := edd.ComputeDelegatedValues(big.NewInt(29), 3)
+		assert.Nil(t, values)
+		assert.True(t, errors.Is(err, ErrMinimumDelegationNotMet))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		edd, err := NewEqualDelegatorDistribution(big.NewInt(0))
+		require.Nil(t, err)
+
+		values, err := edd.ComputeDelegatedValues(big.NewInt(11), 3)
+		require.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(3)}, values)
+	})
+}
+
+func TestNewRandomDelegatorDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("maximum lower than minimum should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdd, err := NewRandomDelegatorDistribution(big.NewInt(10), big.NewInt(9), &mock.IntRandomizerStub{})
+		assert.Nil(t, rdd)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("nil randomizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdd, err := NewRandomDelegatorDistribution(big.NewInt(10), big.NewInt(20), nil)
+		assert.Nil(t, rdd)
+		assert.Equal(t, ErrNilRandomizer, err)
+	})
+	t.Run("maximum delegation exceeded should error", func(t *testing.T) {
+		t.Parallel()
+
+		rdd, _ := NewRandomDelegatorDistribution(big.NewInt(10), big.NewInt(20), &mock.IntRandomizerStub{})
+		values, err := rdd.ComputeDelegatedValues(big.NewInt(61), 3)
+		assert.Nil(t, values)
+		assert.True(t, errors.Is(err, ErrMaximumDelegationExceeded))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		minDelegation := big.NewInt(1000)
+		maxDelegation := big.NewInt(50000)
+		rdd, err := NewRandomDelegatorDistribution(minDelegation, maxDelegation, createSequenceRandomizer())
+		require.Nil(t, err)
+
+		totalDelegated := big.NewInt(1234567)
+		values, err := rdd.ComputeDelegatedValues(totalDelegated, 100)
+		require.Nil(t, err)
+		require.Equal(t, 100, len(values))
+		assert.Equal(t, totalDelegated, sumDelegatedValues(values))
+		for _, value := range values {
+			assert.True(t, value.Cmp(minDelegation) >= 0)
+			assert.True(t, value.Cmp(maxDelegation) <= 0)
+		}
+	})
+	t.Run("all delegators at the maximum should work", func(t *testing.T) {
+		t.Parallel()
+
+		rdd, _ := NewRandomDelegatorDistribution(big.NewInt(10), big.NewInt(20), createSequenceRandomizer())
+		values, err := rdd.ComputeDelegatedValues(big.NewInt(60), 3)
+		require.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(20), big.NewInt(20), big.NewInt(20)}, values)
+	})
+}
+
+func TestNewParetoDelegatorDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid alpha should error", func(t *testing.T) {
+		t.Parallel()
+
+		pdd, err := NewParetoDelegatorDistribution(big.NewInt(0), 0, &mock.IntRandomizerStub{})
+		assert.Nil(t, pdd)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("nil randomizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		pdd, err := NewParetoDelegatorDistribution(big.NewInt(0), 1.16, nil)
+		assert.Nil(t, pdd)
+		assert.Equal(t, ErrNilRandomizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		minDelegation := big.NewInt(1000)
+		pdd, err := NewParetoDelegatorDistribution(minDelegation, 1.16, createSequenceRandomizer())
+		require.Nil(t, err)
+
+		totalDelegated, _ := big.NewInt(0).SetString("2500000000000000000000000", 10)
+		values, err := pdd.ComputeDelegatedValues(totalDelegated, 1000)
+		require.Nil(t, err)
+		require.Equal(t, 1000, len(values))
+		assert.Equal(t, totalDelegated, sumDelegatedValues(values))
+
+		smallest := values[0]
+		largest := values[0]
+		for _, value := range values {
+			assert.True(t, value.Cmp(minDelegation) >= 0)
+			if value.Cmp(smallest) < 0 {
+				smallest = value
+			}
+			if value.Cmp(largest) > 0 {
+				largest = value
+			}
+		}
+		// the whales should hold orders of magnitude more than the dust delegators
+		ratio := big.NewInt(0).Div(largest, smallest)
+		assert.True(t, ratio.Cmp(big.NewInt(100)) > 0)
+	})
+}
+
+func TestNewListDelegatorDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty list should error", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, err := NewListDelegatorDistribution(nil, big.NewInt(0))
+		assert.Nil(t, ldd)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("value lower than the minimum should error", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, err := NewListDelegatorDistribution([]*big.Int{big.NewInt(10), big.NewInt(4)}, big.NewInt(5))
+		assert.Nil(t, ldd)
+		assert.True(t, errors.Is(err, ErrMinimumDelegationNotMet))
+	})
+	t.Run("number of values mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, _ := NewListDelegatorDistribution([]*big.Int{big.NewInt(10), big.NewInt(5)}, big.NewInt(0))
+		values, err := ldd.ComputeDelegatedValues(big.NewInt(15), 3)
+		assert.Nil(t, values)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfDelegatedValues))
+	})
+	t.Run("sum mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, _ := NewListDelegatorDistribution([]*big.Int{big.NewInt(10), big.NewInt(5)}, big.NewInt(0))
+		values, err := ldd.ComputeDelegatedValues(big.NewInt(16), 2)
+		assert.Nil(t, values)
+		assert.True(t, errors.Is(err, ErrTotalDelegatedMismatch))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, err := NewListDelegatorDistribution([]*big.Int{big.NewInt(10), big.NewInt(5)}, big.NewInt(5))
+		require.Nil(t, err)
+
+		values, err := ldd.ComputeDelegatedValues(big.NewInt(15), 2)
+		require.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(5)}, values)
+	})
+}
+
+func TestListDelegatorDistribution_SplitForProviders(t *testing.T) {
+	t.Parallel()
+
+	values := []*big.Int{big.NewInt(10), big.NewInt(5), big.NewInt(7), big.NewInt(3), big.NewInt(20)}
+
+	t.Run("number of values mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, _ := NewListDelegatorDistribution(values, big.NewInt(0))
+		distributions, err := ldd.SplitForProviders([]int{2, 2})
+		assert.Nil(t, distributions)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfDelegatedValues))
+	})
+	t.Run("should split the values in order", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, _ := NewListDelegatorDistribution(values, big.NewInt(0))
+		distributions, err := ldd.SplitForProviders([]int{2, 3})
+		require.Nil(t, err)
+		require.Equal(t, 2, len(distributions))
+
+		firstValues, err := distributions[0].ComputeDelegatedValues(big.NewInt(15), 2)
+		require.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(5)}, firstValues)
+
+		secondValues, err := distributions[1].ComputeDelegatedValues(big.NewInt(30), 3)
+		require.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(7), big.NewInt(3), big.NewInt(20)}, secondValues)
+	})
+	t.Run("slice sum mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ldd, _ := NewListDelegatorDistribution(values, big.NewInt(0))
+		distributions, err := ldd.SplitForProviders([]int{3, 2})
+		require.Nil(t, err)
+
+		// the whole list sums up to 45, but the first slice holds 10 + 5 + 7
+		delegatedValues, err := distributions[0].ComputeDelegatedValues(big.NewInt(15), 3)
+		assert.Nil(t, delegatedValues)
+		assert.True(t, errors.Is(err, ErrTotalDelegatedMismatch))
+	})
+}
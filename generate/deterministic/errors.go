@@ -0,0 +1,15 @@
+package deterministic
+
+import "errors"
+
+// ErrEmptySeed signals that an empty seed was provided
+var ErrEmptySeed = errors.New("empty seed")
+
+// ErrNilReader signals that a nil reader was provided
+var ErrNilReader = errors.New("nil reader")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrInvalidMnemonic signals that the provided mnemonic is not a valid BIP39 mnemonic
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
@@ -0,0 +1,49 @@
+package deterministic
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+type intRandomizer struct {
+	reader io.Reader
+}
+
+// NewIntRandomizer creates an int randomizer that draws its values from the provided reader
+func NewIntRandomizer(reader io.Reader) (*intRandomizer, error) {
+	if reader == nil {
+		return nil, ErrNilReader
+	}
+
+	return &intRandomizer{
+		reader: reader,
+	}, nil
+}
+
+// Intn returns a value in the [0, n) interval. Returns 0 if n is lower or equal to 1
+func (ir *intRandomizer) Intn(n int) int {
+	if n <= 1 {
+		return 0
+	}
+
+	// rejection sampling to avoid the modulo bias
+	maxAcceptable := math.MaxUint64 - math.MaxUint64%uint64(n)
+	buff := make([]byte, 8)
+	for {
+		_, err := io.ReadFull(ir.reader, buff)
+		if err != nil {
+			panic("unable to read the random value: " + err.Error())
+		}
+
+		value := binary.BigEndian.Uint64(buff)
+		if value < maxAcceptable {
+			return int(value % uint64(n))
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ir *intRandomizer) IsInterfaceNil() bool {
+	return ir == nil
+}
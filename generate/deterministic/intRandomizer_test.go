@@ -0,0 +1,24 @@
+package deterministic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntRandomizer_IntnShouldBeDeterministicAndInRange(t *testing.T) {
+	t.Parallel()
+
+	reader1, _ := NewRandomReader([]byte("seed"), "randomizer")
+	reader2, _ := NewRandomReader([]byte("seed"), "randomizer")
+	ir1, _ := NewIntRandomizer(reader1)
+	ir2, _ := NewIntRandomizer(reader2)
+
+	for i := 0; i < 1000; i++ {
+		value := ir1.Intn(7)
+		assert.Equal(t, value, ir2.Intn(7))
+		assert.True(t, value >= 0 && value < 7)
+	}
+	assert.Equal(t, 0, ir1.Intn(1))
+	assert.Equal(t, 0, ir1.Intn(0))
+}
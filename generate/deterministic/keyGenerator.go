@@ -0,0 +1,56 @@
+package deterministic
+
+import (
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+const secretKeySeedLength = 32
+
+type keyGenerator struct {
+	crypto.KeyGenerator
+	reader io.Reader
+}
+
+// NewKeyGenerator wraps the provided key generator so that the generated pairs are derived from the bytes
+// provided by the reader instead of the system's random number generator
+func NewKeyGenerator(keyGen crypto.KeyGenerator, reader io.Reader) (*keyGenerator, error) {
+	if check.IfNil(keyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+	if reader == nil {
+		return nil, ErrNilReader
+	}
+
+	return &keyGenerator{
+		KeyGenerator: keyGen,
+		reader:       reader,
+	}, nil
+}
+
+// GeneratePair will generate a bundle of private and public key using the bytes from the inner reader
+func (kg *keyGenerator) GeneratePair() (crypto.PrivateKey, crypto.PublicKey) {
+	buff := make([]byte, secretKeySeedLength)
+	for {
+		_, err := io.ReadFull(kg.reader, buff)
+		if err != nil {
+			panic("unable to read the secret key seed: " + err.Error())
+		}
+
+		sk, err := kg.KeyGenerator.PrivateKeyFromByteArray(buff)
+		if err != nil {
+			// the drawn bytes do not represent a valid secret key for the suite (e.g. out of the field order),
+			// drawing again keeps the process deterministic
+			continue
+		}
+
+		return sk, sk.GeneratePublic()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kg *keyGenerator) IsInterfaceNil() bool {
+	return kg == nil
+}
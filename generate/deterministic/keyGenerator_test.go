@@ -0,0 +1,88 @@
+package deterministic
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generatePairsBytes(t *testing.T, kg *keyGenerator, numPairs int) ([][]byte, [][]byte) {
+	sks := make([][]byte, 0, numPairs)
+	pks := make([][]byte, 0, numPairs)
+	for i := 0; i < numPairs; i++ {
+		sk, pk := kg.GeneratePair()
+		skBytes, err := sk.ToByteArray()
+		require.Nil(t, err)
+		pkBytes, err := pk.ToByteArray()
+		require.Nil(t, err)
+
+		sks = append(sks, skBytes)
+		pks = append(pks, pkBytes)
+	}
+
+	return sks, pks
+}
+
+func TestNewKeyGenerator_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	reader, _ := NewRandomReader([]byte("seed"), "")
+	kg, err := NewKeyGenerator(nil, reader)
+	assert.Nil(t, kg)
+	assert.Equal(t, ErrNilKeyGenerator, err)
+
+	kg, err = NewKeyGenerator(signing.NewKeyGenerator(ed25519.NewEd25519()), nil)
+	assert.Nil(t, kg)
+	assert.Equal(t, ErrNilReader, err)
+}
+
+func TestKeyGenerator_GeneratePairShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	testSuite := func(t *testing.T, createKeyGen func() *keyGenerator, expectedPkLen int) {
+		sks1, pks1 := generatePairsBytes(t, createKeyGen(), 10)
+		sks2, pks2 := generatePairsBytes(t, createKeyGen(), 10)
+
+		assert.Equal(t, sks1, sks2)
+		assert.Equal(t, pks1, pks2)
+		for i := 1; i < len(pks1); i++ {
+			assert.NotEqual(t, pks1[0], pks1[i])
+			assert.Equal(t, expectedPkLen, len(pks1[i]))
+		}
+	}
+
+	t.Run("ed25519", func(t *testing.T) {
+		testSuite(t, func() *keyGenerator {
+			reader, _ := NewRandomReader([]byte("seed"), "wallet")
+			kg, _ := NewKeyGenerator(signing.NewKeyGenerator(ed25519.NewEd25519()), reader)
+			return kg
+		}, 32)
+	})
+	t.Run("BLS12", func(t *testing.T) {
+		testSuite(t, func() *keyGenerator {
+			reader, _ := NewRandomReader([]byte("seed"), "validator")
+			kg, _ := NewKeyGenerator(signing.NewKeyGenerator(mcl.NewSuiteBLS12()), reader)
+			return kg
+		}, 96)
+	})
+}
+
+func TestKeyGenerator_GeneratedPrivateKeyShouldDeriveThePublicKey(t *testing.T) {
+	t.Parallel()
+
+	reader, _ := NewRandomReader([]byte("seed"), "validator")
+	kg, _ := NewKeyGenerator(signing.NewKeyGenerator(mcl.NewSuiteBLS12()), reader)
+
+	sks, pks := generatePairsBytes(t, kg, 5)
+	for i := range sks {
+		sk, err := kg.PrivateKeyFromByteArray(sks[i])
+		require.Nil(t, err)
+
+		pkBytes, _ := sk.GeneratePublic().ToByteArray()
+		assert.Equal(t, pks[i], pkBytes)
+	}
+}
@@ -0,0 +1,135 @@
+package deterministic
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const mnemonicEntropySize = 32
+const hardenedOffset = uint32(0x80000000)
+const egldCoinType = uint32(508)
+const slip10Ed25519Key = "ed25519 seed"
+
+// DerivationPathFormat is the MultiversX HD derivation path used for the wallet keys, the account index being the
+// only variable part
+const DerivationPathFormat = "m/44'/508'/0'/0'/%d'"
+
+type mnemonicKeyGenerator struct {
+	crypto.KeyGenerator
+	mut       sync.RWMutex
+	mnemonic  string
+	seed      []byte
+	nextIndex uint32
+	indexes   map[string]uint32
+}
+
+// GenerateMnemonic creates a new 24 words BIP39 mnemonic using the entropy provided by the reader
+func GenerateMnemonic(entropyReader io.Reader) (string, error) {
+	if entropyReader == nil {
+		return "", ErrNilReader
+	}
+
+	entropy := make([]byte, mnemonicEntropySize)
+	_, err := io.ReadFull(entropyReader, entropy)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// NewMnemonicKeyGenerator wraps the provided ed25519 key generator so that the generated pairs are derived, in
+// sequence, from the provided BIP39 mnemonic on the m/44'/508'/0'/0'/index' path
+func NewMnemonicKeyGenerator(keyGen crypto.KeyGenerator, mnemonic string) (*mnemonicKeyGenerator, error) {
+	if check.IfNil(keyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+
+	return &mnemonicKeyGenerator{
+		KeyGenerator: keyGen,
+		mnemonic:     mnemonic,
+		seed:         bip39.NewSeed(mnemonic, ""),
+		indexes:      make(map[string]uint32),
+	}, nil
+}
+
+// GeneratePair will derive the key pair found at the next account index
+func (mkg *mnemonicKeyGenerator) GeneratePair() (crypto.PrivateKey, crypto.PublicKey) {
+	mkg.mut.Lock()
+	defer mkg.mut.Unlock()
+
+	index := mkg.nextIndex
+	sk, err := mkg.KeyGenerator.PrivateKeyFromByteArray(mkg.deriveSecretKey(index))
+	if err != nil {
+		panic(fmt.Sprintf("unable to derive the private key at index %d: %s", index, err.Error()))
+	}
+
+	pk := sk.GeneratePublic()
+	pkBytes, err := pk.ToByteArray()
+	if err != nil {
+		panic(fmt.Sprintf("unable to derive the public key at index %d: %s", index, err.Error()))
+	}
+
+	mkg.indexes[string(pkBytes)] = index
+	mkg.nextIndex++
+
+	return sk, pk
+}
+
+// deriveSecretKey implements the SLIP-0010 ed25519 derivation, all the path levels being hardened
+func (mkg *mnemonicKeyGenerator) deriveSecretKey(accountIndex uint32) []byte {
+	path := []uint32{44, egldCoinType, 0, 0, accountIndex}
+
+	digest := hmac.New(sha512.New, []byte(slip10Ed25519Key))
+	_, _ = digest.Write(mkg.seed)
+	intermediary := digest.Sum(nil)
+	key, chainCode := intermediary[:32], intermediary[32:]
+
+	for _, level := range path {
+		buff := make([]byte, 0, 1+len(key)+4)
+		buff = append(buff, 0x00)
+		buff = append(buff, key...)
+		buff = binary.BigEndian.AppendUint32(buff, level|hardenedOffset)
+
+		digest = hmac.New(sha512.New, chainCode)
+		_, _ = digest.Write(buff)
+		intermediary = digest.Sum(nil)
+		key, chainCode = intermediary[:32], intermediary[32:]
+	}
+
+	return key
+}
+
+// DerivationIndex returns the account index used to derive the provided public key
+func (mkg *mnemonicKeyGenerator) DerivationIndex(pubKey []byte) (uint32, bool) {
+	mkg.mut.RLock()
+	defer mkg.mut.RUnlock()
+
+	index, found := mkg.indexes[string(pubKey)]
+
+	return index, found
+}
+
+// Mnemonic returns the mnemonic used to derive the keys
+func (mkg *mnemonicKeyGenerator) Mnemonic() string {
+	return mkg.mnemonic
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mkg *mnemonicKeyGenerator) IsInterfaceNil() bool {
+	return mkg == nil
+}
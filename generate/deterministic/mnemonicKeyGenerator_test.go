@@ -0,0 +1,63 @@
+package deterministic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather " +
+	"prepare woman film husband gravity behind test tiger improve"
+
+func TestGenerateMnemonic_ShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	reader1, _ := NewRandomReader([]byte("seed"), "mnemonic")
+	reader2, _ := NewRandomReader([]byte("seed"), "mnemonic")
+
+	mnemonic1, err := GenerateMnemonic(reader1)
+	require.Nil(t, err)
+	mnemonic2, _ := GenerateMnemonic(reader2)
+
+	assert.Equal(t, mnemonic1, mnemonic2)
+	assert.Equal(t, 24, len(strings.Fields(mnemonic1)))
+}
+
+func TestNewMnemonicKeyGenerator_InvalidMnemonicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mkg, err := NewMnemonicKeyGenerator(signing.NewKeyGenerator(ed25519.NewEd25519()), "not a mnemonic")
+	assert.Nil(t, mkg)
+	assert.Equal(t, ErrInvalidMnemonic, err)
+}
+
+func TestMnemonicKeyGenerator_GeneratePairShouldFollowTheMultiversXPath(t *testing.T) {
+	t.Parallel()
+
+	mkg, err := NewMnemonicKeyGenerator(signing.NewKeyGenerator(ed25519.NewEd25519()), testMnemonic)
+	require.Nil(t, err)
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	expectedAddresses := []string{
+		"erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+		"erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx",
+	}
+	for i, expectedAddress := range expectedAddresses {
+		_, pk := mkg.GeneratePair()
+		pkBytes, _ := pk.ToByteArray()
+		address, _ := converter.Encode(pkBytes)
+		assert.Equal(t, expectedAddress, address)
+
+		index, found := mkg.DerivationIndex(pkBytes)
+		assert.True(t, found)
+		assert.Equal(t, uint32(i), index)
+	}
+
+	_, found := mkg.DerivationIndex([]byte("unknown"))
+	assert.False(t, found)
+}
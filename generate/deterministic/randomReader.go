@@ -0,0 +1,73 @@
+package deterministic
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"sync"
+)
+
+// randomReader is a deterministic random bit generator implemented as an HMAC_DRBG (NIST SP 800-90A) over SHA-256.
+// The same seed and personalization string will always produce the same stream of bytes.
+type randomReader struct {
+	mut   sync.Mutex
+	key   []byte
+	value []byte
+}
+
+// NewRandomReader creates a new deterministic random reader. The personalization string is used to
+// obtain independent streams out of the same seed
+func NewRandomReader(seed []byte, personalization string) (*randomReader, error) {
+	if len(seed) == 0 {
+		return nil, ErrEmptySeed
+	}
+
+	rr := &randomReader{
+		key:   make([]byte, sha256.Size),
+		value: make([]byte, sha256.Size),
+	}
+	for i := range rr.value {
+		rr.value[i] = 0x01
+	}
+
+	seedMaterial := make([]byte, 0, len(seed)+len(personalization))
+	seedMaterial = append(seedMaterial, seed...)
+	seedMaterial = append(seedMaterial, personalization...)
+	rr.update(seedMaterial)
+
+	return rr, nil
+}
+
+// Read fills the provided buffer with the next bytes from the stream. It never returns an error.
+func (rr *randomReader) Read(p []byte) (int, error) {
+	rr.mut.Lock()
+	defer rr.mut.Unlock()
+
+	numGenerated := 0
+	for numGenerated < len(p) {
+		rr.value = rr.hmac(rr.key, rr.value)
+		numGenerated += copy(p[numGenerated:], rr.value)
+	}
+	rr.update(nil)
+
+	return len(p), nil
+}
+
+func (rr *randomReader) update(providedData []byte) {
+	rr.key = rr.hmac(rr.key, rr.value, []byte{0x00}, providedData)
+	rr.value = rr.hmac(rr.key, rr.value)
+	if len(providedData) == 0 {
+		return
+	}
+
+	rr.key = rr.hmac(rr.key, rr.value, []byte{0x01}, providedData)
+	rr.value = rr.hmac(rr.key, rr.value)
+}
+
+func (rr *randomReader) hmac(key []byte, data ...[]byte) []byte {
+	h := hmac.New(sha256.New, key)
+	for _, d := range data {
+		_, _ = h.Write(d)
+	}
+
+	return h.Sum(nil)
+}
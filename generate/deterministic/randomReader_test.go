@@ -0,0 +1,45 @@
+package deterministic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRandomReader_EmptySeedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rr, err := NewRandomReader(nil, "")
+	assert.Nil(t, rr)
+	assert.Equal(t, ErrEmptySeed, err)
+}
+
+func TestRandomReader_SameSeedShouldProduceSameStream(t *testing.T) {
+	t.Parallel()
+
+	rr1, err := NewRandomReader([]byte("seed"), "label")
+	require.Nil(t, err)
+	rr2, _ := NewRandomReader([]byte("seed"), "label")
+
+	buff1 := make([]byte, 100)
+	buff2 := make([]byte, 100)
+	for i := 0; i < 3; i++ {
+		_, _ = rr1.Read(buff1)
+		_, _ = rr2.Read(buff2)
+		assert.Equal(t, buff1, buff2)
+	}
+}
+
+func TestRandomReader_DifferentPersonalizationShouldProduceDifferentStreams(t *testing.T) {
+	t.Parallel()
+
+	rr1, _ := NewRandomReader([]byte("seed"), "label 1")
+	rr2, _ := NewRandomReader([]byte("seed"), "label 2")
+
+	buff1 := make([]byte, 32)
+	buff2 := make([]byte, 32)
+	_, _ = rr1.Read(buff1)
+	_, _ = rr2.Read(buff2)
+	assert.NotEqual(t, buff1, buff2)
+}
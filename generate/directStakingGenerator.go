@@ -10,18 +10,27 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 )
 
-var minimumInitialBalance = big.NewInt(1000000000000000000) // 1eGLD
 var zero = big.NewInt(0)
 
 type directStakingGenerator struct {
 	*baseGenerator
-	maxNumNodesOnOwner uint
+	ownerDistribution OwnerDistribution
+	topUpPolicy       TopUpPolicy
 }
 
 // NewDirectStakingGenerator will create a direct staking generator
 func NewDirectStakingGenerator(arg ArgDirectStakingGenerator) (*directStakingGenerator, error) {
-	if arg.MaxNumNodesOnOwner == 0 {
-		return nil, fmt.Errorf("%w for MaxNumNodesOnOwner", ErrInvalidValue)
+	if check.IfNil(arg.OwnerDistribution) {
+		return nil, ErrNilOwnerDistribution
+	}
+	if check.IfNil(arg.TopUpPolicy) {
+		return nil, ErrNilTopUpPolicy
+	}
+	if check.IfNil(arg.BalancePolicy) {
+		return nil, ErrNilBalancePolicy
+	}
+	if check.IfNil(arg.RatingProfile) {
+		return nil, ErrNilRatingProfile
 	}
 	if check.IfNil(arg.WalletPubKeyConverter) {
 		return nil, fmt.Errorf("%w for the WalletPubKeyConverter", ErrNilPubKeyConverter)
@@ -29,21 +38,21 @@ func NewDirectStakingGenerator(arg ArgDirectStakingGenerator) (*directStakingGen
 	if check.IfNil(arg.ValidatorPubKeyConverter) {
 		return nil, fmt.Errorf("%w for the ValidatorPubKeyConverter", ErrNilPubKeyConverter)
 	}
-	if check.IfNil(arg.IntRandomizer) {
-		return nil, ErrNilRandomizer
-	}
 
 	dsg := &directStakingGenerator{
 		baseGenerator: &baseGenerator{
 			numValidatorBlsKeys:      arg.NumValidatorBlsKeys,
 			numObserverBlsKeys:       arg.NumObserverBlsKeys,
-			richestAccountMode:       arg.RichestAccountMode,
+			balancePolicy:            arg.BalancePolicy,
 			numAdditionalWalletKeys:  arg.NumAdditionalWalletKeys,
 			totalSupply:              arg.TotalSupply,
 			walletPubKeyConverter:    arg.WalletPubKeyConverter,
 			validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+			ratingProfile:            arg.RatingProfile,
+			importedValidatorBlsKeys: arg.ImportedValidatorBlsKeys,
 		},
-		maxNumNodesOnOwner: arg.MaxNumNodesOnOwner,
+		ownerDistribution: arg.OwnerDistribution,
+		topUpPolicy:       arg.TopUpPolicy,
 	}
 	var err error
 	dsg.vkg, err = NewValidatorKeyGenerator(arg.KeyGeneratorForValidators)
@@ -51,7 +60,7 @@ func NewDirectStakingGenerator(arg ArgDirectStakingGenerator) (*directStakingGen
 		return nil, err
 	}
 
-	dsg.wkg, err = NewWalletKeyGenerator(arg.KeyGeneratorForWallets, arg.IntRandomizer, arg.NodePrice)
+	dsg.wkg, err = NewWalletKeyGenerator(arg.KeyGeneratorForWallets, arg.NodePrice)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +75,7 @@ func (dsg *directStakingGenerator) Generate() (*data.GeneratorOutput, error) {
 		return nil, err
 	}
 
-	walletKeys, err := dsg.wkg.GenerateKeys(validatorBlsKeys, int(dsg.maxNumNodesOnOwner))
+	walletKeys, err := dsg.wkg.GenerateKeys(validatorBlsKeys, dsg.ownerDistribution, dsg.topUpPolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -87,17 +96,9 @@ func (dsg *directStakingGenerator) Generate() (*data.GeneratorOutput, error) {
 			dsg.totalSupply.String(), usedBalance.String())
 	}
 
-	walletBalance, remainder := dsg.computeWalletBalance(len(walletKeys)+len(additionalKeys), balance)
-
-	for i, key := range walletKeys {
-		key.Balance = big.NewInt(0).Set(walletBalance)
-		if i == 0 {
-			key.Balance.Add(key.Balance, remainder)
-		}
-	}
-
-	for _, key := range additionalKeys {
-		key.Balance = big.NewInt(0).Set(walletBalance)
+	treasury, err := dsg.balancePolicy.DistributeBalance(balance, walletKeys, additionalKeys)
+	if err != nil {
+		return nil, err
 	}
 
 	gen := &data.GeneratorOutput{
@@ -107,6 +108,7 @@ func (dsg *directStakingGenerator) Generate() (*data.GeneratorOutput, error) {
 		AdditionalKeys:   additionalKeys,
 	}
 	gen.InitialAccounts = dsg.computeInitialAccounts(walletKeys, additionalKeys)
+	gen.InitialAccounts = dsg.appendTreasuryAccount(gen.InitialAccounts, treasury)
 	gen.InitialNodes = dsg.computeInitialNodes(walletKeys)
 
 	return gen, nil
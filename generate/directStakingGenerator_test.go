@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-deploy-go/check"
+	"github.com/multiversx/mx-chain-deploy-go/core"
 	"github.com/multiversx/mx-chain-deploy-go/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,13 +25,12 @@ func createMockDirectStakingGeneratorArguments() ArgDirectStakingGenerator {
 		KeyGeneratorForWallets:    signing.NewKeyGenerator(edSuite),
 		NumValidatorBlsKeys:       0,
 		NumObserverBlsKeys:        0,
-		RichestAccountMode:        false,
-		MaxNumNodesOnOwner:        0,
+		BalancePolicy:             createTestBalancePolicy(big.NewInt(0), false),
 		NumAdditionalWalletKeys:   0,
-		IntRandomizer:             &mock.IntRandomizerStub{},
 		NodePrice:                 big.NewInt(2500),
 		TotalSupply:               big.NewInt(20000000),
-		InitialRating:             50,
+		RatingProfile:             createTestRatingProfile(50),
+		TopUpPolicy:               NewNoneTopUpPolicy(),
 	}
 	arg.WalletPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
 	arg.ValidatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(96)
@@ -43,7 +44,7 @@ func TestDirectStakingGenerator_GenerateShouldWork(t *testing.T) {
 	arg := createMockDirectStakingGeneratorArguments()
 	arg.NumValidatorBlsKeys = 33
 	arg.NumObserverBlsKeys = 3
-	arg.MaxNumNodesOnOwner = 1
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
 	arg.NumAdditionalWalletKeys = 3
 
 	dsg, err := NewDirectStakingGenerator(arg)
@@ -61,8 +62,15 @@ func TestDirectStakingGenerator_GenerateShouldWork(t *testing.T) {
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
 	assert.Equal(t, 0, len(generatedOutput.DelegatorKeys))
 
-	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply)
-	assert.Nil(t, err, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+
+	goc, _ := check.NewGeneratorOutputChecker(check.ArgGeneratorOutputChecker{
+		NodePrice:                arg.NodePrice,
+		WalletPubKeyConverter:    arg.WalletPubKeyConverter,
+		ValidatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+	})
+	assert.Nil(t, goc.CheckGeneratorOutput(generatedOutput))
 }
 
 func TestDirectStakingGenerator_GenerateWithRichestAccountShouldWork(t *testing.T) {
@@ -75,9 +83,9 @@ func TestDirectStakingGenerator_GenerateWithRichestAccountShouldWork(t *testing.
 	arg.NodePrice.SetString("2500000000000000000000", 10)
 	arg.NumValidatorBlsKeys = 33
 	arg.NumObserverBlsKeys = 3
-	arg.MaxNumNodesOnOwner = 1
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
 	arg.NumAdditionalWalletKeys = 3
-	arg.RichestAccountMode = true
+	arg.BalancePolicy = createTestBalancePolicy(testMinimumBalance, true)
 
 	dsg, err := NewDirectStakingGenerator(arg)
 	require.Nil(t, err)
@@ -94,13 +102,199 @@ func TestDirectStakingGenerator_GenerateWithRichestAccountShouldWork(t *testing.
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
 	assert.Equal(t, 0, len(generatedOutput.DelegatorKeys))
 
-	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply)
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
 	assert.Nil(t, err, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
 	for i, ia := range generatedOutput.InitialAccounts {
 		if i == 0 {
-			assert.NotEqual(t, minimumInitialBalance, ia.Balance)
+			assert.NotEqual(t, testMinimumBalance, ia.Balance)
 		} else {
-			assert.Equal(t, minimumInitialBalance, ia.Balance)
+			assert.Equal(t, testMinimumBalance, ia.Balance)
+		}
+	}
+}
+
+func TestDirectStakingGenerator_GenerateWithImportedKeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.NumObserverBlsKeys = 2
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+
+	vkg, _ := NewValidatorKeyGenerator(arg.KeyGeneratorForValidators)
+	arg.ImportedValidatorBlsKeys, _ = vkg.GenerateKeys(3)
+	// the secret key of an imported key might not be known
+	arg.ImportedValidatorBlsKeys[1].PrivKeyBytes = nil
+
+	dsg, err := NewDirectStakingGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	require.Nil(t, err)
+
+	require.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.ValidatorBlsKeys))
+	require.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
+	for i, importedKey := range arg.ImportedValidatorBlsKeys {
+		assert.Equal(t, importedKey, generatedOutput.ValidatorBlsKeys[i])
+
+		expectedPubKey, _ := arg.ValidatorPubKeyConverter.Encode(importedKey.PubKeyBytes)
+		assert.Equal(t, expectedPubKey, generatedOutput.InitialNodes[i].PubKey)
+	}
+
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+}
+
+func TestDirectStakingGenerator_GenerateWithTooManyImportedKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 2
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+
+	vkg, _ := NewValidatorKeyGenerator(arg.KeyGeneratorForValidators)
+	arg.ImportedValidatorBlsKeys, _ = vkg.GenerateKeys(3)
+
+	dsg, _ := NewDirectStakingGenerator(arg)
+	generatedOutput, err := dsg.Generate()
+	assert.Nil(t, generatedOutput)
+	assert.True(t, errors.Is(err, ErrTooManyImportedBlsKeys))
+}
+
+func TestDirectStakingGenerator_GenerateWithDuplicatedImportedKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 5
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+
+	vkg, _ := NewValidatorKeyGenerator(arg.KeyGeneratorForValidators)
+	importedKeys, _ := vkg.GenerateKeys(1)
+	arg.ImportedValidatorBlsKeys = append(importedKeys, importedKeys[0])
+
+	dsg, _ := NewDirectStakingGenerator(arg)
+	generatedOutput, err := dsg.Generate()
+	assert.Nil(t, generatedOutput)
+	assert.True(t, errors.Is(err, ErrDuplicatedBlsKey))
+}
+
+func TestDirectStakingGenerator_GenerateWithTopUpShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 12
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(3)
+	arg.TopUpPolicy = NewProportionalTopUpPolicy(10)
+
+	dsg, err := NewDirectStakingGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	require.Nil(t, err)
+
+	require.Equal(t, 4, len(generatedOutput.WalletKeys))
+	for _, key := range generatedOutput.WalletKeys {
+		assert.Equal(t, big.NewInt(8250), key.StakedValue)
+	}
+
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+}
+
+func TestDirectStakingGenerator_NilTopUpPolicyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+	arg.TopUpPolicy = nil
+
+	dsg, err := NewDirectStakingGenerator(arg)
+	assert.Nil(t, dsg)
+	assert.Equal(t, ErrNilTopUpPolicy, err)
+}
+
+func TestDirectStakingGenerator_NilRatingProfileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+	arg.RatingProfile = nil
+
+	dsg, err := NewDirectStakingGenerator(arg)
+	assert.Nil(t, dsg)
+	assert.Equal(t, ErrNilRatingProfile, err)
+}
+
+func TestDirectStakingGenerator_GenerateWithRatingProfileShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(2)
+	overriddenOwner := make([]byte, 0)
+	arg.RatingProfile = &mock.RatingProfileStub{
+		ComputeRatingCalled: func(ownerPubKeyBytes []byte) uint32 {
+			if len(overriddenOwner) == 0 {
+				overriddenOwner = ownerPubKeyBytes
+			}
+			if string(ownerPubKeyBytes) == string(overriddenOwner) {
+				return 7
+			}
+
+			return 50
+		},
+	}
+
+	dsg, err := NewDirectStakingGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	require.Nil(t, err)
+
+	overriddenAddress, _ := arg.WalletPubKeyConverter.Encode(overriddenOwner)
+	require.Equal(t, 10, len(generatedOutput.InitialNodes))
+	for _, node := range generatedOutput.InitialNodes {
+		if node.Address == overriddenAddress {
+			assert.Equal(t, uint32(7), node.InitialRating)
+			continue
 		}
+
+		assert.Equal(t, uint32(50), node.InitialRating)
 	}
 }
+
+func TestDirectStakingGenerator_GenerateWithTreasuryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDirectStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 10
+	arg.NumAdditionalWalletKeys = 2
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+	treasuryAddress := "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80"
+	treasuryPkBytes, _ := arg.WalletPubKeyConverter.Decode(treasuryAddress)
+	arg.BalancePolicy, _ = NewBalancePolicy(ArgBalancePolicy{
+		MinOwnerBalance:      big.NewInt(0),
+		MinDelegatorBalance:  big.NewInt(0),
+		MinAdditionalBalance: big.NewInt(0),
+		RemainderSink:        core.TreasuryRemainderSink,
+		TreasuryPubKeyBytes:  treasuryPkBytes,
+		TreasuryPercent:      10,
+		TotalSupply:          arg.TotalSupply,
+	})
+
+	dsg, err := NewDirectStakingGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := dsg.Generate()
+	require.Nil(t, err)
+
+	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumAdditionalWalletKeys + 1
+	require.Equal(t, int(expectedNumInitialAccounts), len(generatedOutput.InitialAccounts))
+	treasuryAccount := generatedOutput.InitialAccounts[len(generatedOutput.InitialAccounts)-1]
+	assert.Equal(t, treasuryAddress, treasuryAccount.Address)
+	// 10% of the total supply plus the remainder of (20000000 - 10 * 2500 - 2000000) / 12
+	assert.Equal(t, big.NewInt(2000008), treasuryAccount.Balance)
+
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+}
@@ -22,3 +22,85 @@ var ErrInvalidNumberOfWalletKeys = errors.New("invalid number of wallet keys")
 
 // ErrNilPubKeyConverter signals that a nil pub key converter was provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrTooManyImportedBlsKeys signals that more BLS keys were imported than the number of validators
+var ErrTooManyImportedBlsKeys = errors.New("too many imported BLS keys")
+
+// ErrDuplicatedBlsKey signals that the same BLS key was provided more than once
+var ErrDuplicatedBlsKey = errors.New("duplicated BLS key")
+
+// ErrNilOwnerDistribution signals that a nil owner distribution was provided
+var ErrNilOwnerDistribution = errors.New("nil owner distribution")
+
+// ErrNilDelegatorDistribution signals that a nil delegator distribution was provided
+var ErrNilDelegatorDistribution = errors.New("nil delegator distribution")
+
+// ErrNilMinimumDelegation signals that a nil minimum delegation was provided
+var ErrNilMinimumDelegation = errors.New("nil minimum delegation")
+
+// ErrMinimumDelegationNotMet signals that the delegated value is lower than the minimum delegation
+var ErrMinimumDelegationNotMet = errors.New("minimum delegation not met")
+
+// ErrMaximumDelegationExceeded signals that the delegated value can not be distributed without exceeding the maximum
+var ErrMaximumDelegationExceeded = errors.New("maximum delegation exceeded")
+
+// ErrInvalidNumberOfDelegatedValues signals that the number of delegated values does not match the number of delegators
+var ErrInvalidNumberOfDelegatedValues = errors.New("invalid number of delegated values")
+
+// ErrTotalDelegatedMismatch signals that the delegated values do not sum up to the total delegated value
+var ErrTotalDelegatedMismatch = errors.New("total delegated value mismatch")
+
+// ErrNoDelegationProviders signals that no delegation provider was provided
+var ErrNoDelegationProviders = errors.New("no delegation providers")
+
+// ErrInvalidNumberOfDelegatedNodes signals that the delegation providers nodes do not match the number of delegated nodes
+var ErrInvalidNumberOfDelegatedNodes = errors.New("invalid number of delegated nodes")
+
+// ErrDuplicatedDelegationContract signals that two delegation providers resolve to the same delegation contract
+var ErrDuplicatedDelegationContract = errors.New("duplicated delegation contract")
+
+// ErrInvalidDelegationOwnerNonce signals that the nonces of a delegation owner do not form the 0, 1, 2... sequence
+// required to deploy its delegation contracts at genesis
+var ErrInvalidDelegationOwnerNonce = errors.New("invalid delegation owner nonce")
+
+// ErrNilTopUpPolicy signals that a nil top-up policy was provided
+var ErrNilTopUpPolicy = errors.New("nil top-up policy")
+
+// ErrNilTopUpValue signals that a nil top-up value was provided
+var ErrNilTopUpValue = errors.New("nil top-up value")
+
+// ErrNilBalancePolicy signals that a nil balance policy was provided
+var ErrNilBalancePolicy = errors.New("nil balance policy")
+
+// ErrNilMinimumBalance signals that a nil minimum balance was provided
+var ErrNilMinimumBalance = errors.New("nil minimum balance")
+
+// ErrMissingTreasuryAddress signals that the treasury address is required, but was not provided
+var ErrMissingTreasuryAddress = errors.New("missing treasury address")
+
+// ErrUnknownRemainderSink signals that an unknown remainder sink was provided
+var ErrUnknownRemainderSink = errors.New("unknown remainder sink")
+
+// ErrMissingRemainderSink signals that the account receiving the balance remainder does not exist
+var ErrMissingRemainderSink = errors.New("missing remainder sink")
+
+// ErrNilTotalSupply signals that a nil total supply was provided
+var ErrNilTotalSupply = errors.New("nil total supply")
+
+// ErrNilRatingProfile signals that a nil rating profile was provided
+var ErrNilRatingProfile = errors.New("nil rating profile")
+
+// ErrNilGeneratorOutput signals that a nil generator output was provided
+var ErrNilGeneratorOutput = errors.New("nil generator output")
+
+// ErrCannotShrinkNetwork signals that the extended network would hold fewer nodes than the existing one
+var ErrCannotShrinkNetwork = errors.New("the network can not be shrunk")
+
+// ErrMissingInitialAccount signals that a wallet key does not have an initial account
+var ErrMissingInitialAccount = errors.New("missing initial account")
+
+// ErrDuplicatedWalletKey signals that a generated wallet key is already used by an existing account
+var ErrDuplicatedWalletKey = errors.New("duplicated wallet key")
+
+// ErrInvalidOwnerSizes signals that the owner sizes do not distribute all the nodes, each owner holding at least a node
+var ErrInvalidOwnerSizes = errors.New("invalid owner sizes")
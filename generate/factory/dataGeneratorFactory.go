@@ -1,12 +1,11 @@
 package factory
 
 import (
-	"fmt"
 	"math/big"
 
 	mxCore "github.com/multiversx/mx-chain-core-go/core"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
-	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
 	"github.com/multiversx/mx-chain-deploy-go/generate"
 )
 
@@ -18,36 +17,33 @@ type ArgDataGenerator struct {
 	ValidatorPubKeyConverter  mxCore.PubkeyConverter
 	NumValidatorBlsKeys       uint
 	NumObserverBlsKeys        uint
-	RichestAccountMode        bool
-	MaxNumNodesOnOwner        uint
+	BalancePolicy             generate.BalancePolicy
+	OwnerDistribution         generate.OwnerDistribution
+	TopUpPolicy               generate.TopUpPolicy
 	NumAdditionalWalletKeys   uint
-	IntRandomizer             generate.IntRandomizer
 	NodePrice                 *big.Int
 	TotalSupply               *big.Int
-	InitialRating             uint64
+	RatingProfile             generate.RatingProfile
 	GenerationType            string
-	DelegationOwnerPkString   string
-	DelegationOwnerNonce      uint64
+	DelegationProviders       []generate.DelegationProvider
 	VmType                    string
-	NumDelegators             uint
+	DelegatorDistribution     generate.DelegatorDistribution
 	NumDelegatedNodes         uint
+	ImportedValidatorBlsKeys  []*data.BlsKey
 }
 
-// CreateDataGenerator will attempt to create a data generator instance
+// CreateDataGenerator will attempt to create a data generator instance out of the registered data generators. The
+// generation type is formatted as <type>[:<parameters>], the parameters being passed to the data generator constructor
 func CreateDataGenerator(arg ArgDataGenerator) (DataGenerator, error) {
-	switch arg.GenerationType {
-	case core.StakedType:
-		return stakedTypeDataGenerator(arg)
-	case core.DelegatedStakeType:
-		return delegatedTypeDataGenerator(arg)
-	case core.MixedType:
-		return mixedTypeDataGenerator(arg)
-	default:
-		return nil, fmt.Errorf("%w: %s", ErrUnknownGenerationType, arg.GenerationType)
+	definition, parameters, err := registry.get(arg.GenerationType)
+	if err != nil {
+		return nil, err
 	}
+
+	return definition.Constructor(arg, parameters)
 }
 
-func stakedTypeDataGenerator(arg ArgDataGenerator) (DataGenerator, error) {
+func stakedTypeDataGenerator(arg ArgDataGenerator, _ string) (DataGenerator, error) {
 	argDirectStaking := generate.ArgDirectStakingGenerator{
 		KeyGeneratorForValidators: arg.KeyGeneratorForValidators,
 		KeyGeneratorForWallets:    arg.KeyGeneratorForWallets,
@@ -55,19 +51,20 @@ func stakedTypeDataGenerator(arg ArgDataGenerator) (DataGenerator, error) {
 		ValidatorPubKeyConverter:  arg.ValidatorPubKeyConverter,
 		NumValidatorBlsKeys:       arg.NumValidatorBlsKeys,
 		NumObserverBlsKeys:        arg.NumObserverBlsKeys,
-		RichestAccountMode:        arg.RichestAccountMode,
-		MaxNumNodesOnOwner:        arg.MaxNumNodesOnOwner,
+		BalancePolicy:             arg.BalancePolicy,
+		OwnerDistribution:         arg.OwnerDistribution,
+		TopUpPolicy:               arg.TopUpPolicy,
 		NumAdditionalWalletKeys:   arg.NumAdditionalWalletKeys,
-		IntRandomizer:             arg.IntRandomizer,
 		NodePrice:                 arg.NodePrice,
 		TotalSupply:               arg.TotalSupply,
-		InitialRating:             arg.InitialRating,
+		RatingProfile:             arg.RatingProfile,
+		ImportedValidatorBlsKeys:  arg.ImportedValidatorBlsKeys,
 	}
 
 	return generate.NewDirectStakingGenerator(argDirectStaking)
 }
 
-func delegatedTypeDataGenerator(arg ArgDataGenerator) (DataGenerator, error) {
+func delegatedTypeDataGenerator(arg ArgDataGenerator, _ string) (DataGenerator, error) {
 	argDelegatedStaking := generate.ArgDelegatedStakingGenerator{
 		KeyGeneratorForValidators: arg.KeyGeneratorForValidators,
 		KeyGeneratorForWallets:    arg.KeyGeneratorForWallets,
@@ -75,21 +72,21 @@ func delegatedTypeDataGenerator(arg ArgDataGenerator) (DataGenerator, error) {
 		ValidatorPubKeyConverter:  arg.ValidatorPubKeyConverter,
 		NumValidatorBlsKeys:       arg.NumValidatorBlsKeys,
 		NumObserverBlsKeys:        arg.NumObserverBlsKeys,
-		RichestAccountMode:        arg.RichestAccountMode,
+		BalancePolicy:             arg.BalancePolicy,
 		NumAdditionalWalletKeys:   arg.NumAdditionalWalletKeys,
 		NodePrice:                 arg.NodePrice,
 		TotalSupply:               arg.TotalSupply,
-		InitialRating:             arg.InitialRating,
-		DelegationOwnerPkString:   arg.DelegationOwnerPkString,
-		DelegationOwnerNonce:      arg.DelegationOwnerNonce,
+		RatingProfile:             arg.RatingProfile,
+		DelegationProviders:       arg.DelegationProviders,
 		VmType:                    arg.VmType,
-		NumDelegators:             arg.NumDelegators,
+		DelegatorDistribution:     arg.DelegatorDistribution,
+		ImportedValidatorBlsKeys:  arg.ImportedValidatorBlsKeys,
 	}
 
 	return generate.NewDelegatedGenerator(argDelegatedStaking)
 }
 
-func mixedTypeDataGenerator(arg ArgDataGenerator) (DataGenerator, error) {
+func mixedTypeDataGenerator(arg ArgDataGenerator, _ string) (DataGenerator, error) {
 	argDelegatedStaking := generate.ArgDelegatedStakingGenerator{
 		KeyGeneratorForValidators: arg.KeyGeneratorForValidators,
 		KeyGeneratorForWallets:    arg.KeyGeneratorForWallets,
@@ -97,22 +94,22 @@ func mixedTypeDataGenerator(arg ArgDataGenerator) (DataGenerator, error) {
 		ValidatorPubKeyConverter:  arg.ValidatorPubKeyConverter,
 		NumValidatorBlsKeys:       arg.NumValidatorBlsKeys,
 		NumObserverBlsKeys:        arg.NumObserverBlsKeys,
-		RichestAccountMode:        arg.RichestAccountMode,
+		BalancePolicy:             arg.BalancePolicy,
 		NumAdditionalWalletKeys:   arg.NumAdditionalWalletKeys,
 		NodePrice:                 arg.NodePrice,
 		TotalSupply:               arg.TotalSupply,
-		InitialRating:             arg.InitialRating,
-		DelegationOwnerPkString:   arg.DelegationOwnerPkString,
-		DelegationOwnerNonce:      arg.DelegationOwnerNonce,
+		RatingProfile:             arg.RatingProfile,
+		DelegationProviders:       arg.DelegationProviders,
 		VmType:                    arg.VmType,
-		NumDelegators:             arg.NumDelegators,
+		DelegatorDistribution:     arg.DelegatorDistribution,
+		ImportedValidatorBlsKeys:  arg.ImportedValidatorBlsKeys,
 	}
 
 	argMixedStaking := generate.ArgMixedStakingGenerator{
 		ArgDelegatedStakingGenerator: argDelegatedStaking,
 		NumDelegatedNodes:            arg.NumDelegatedNodes,
-		MaxNumNodesOnOwner:           arg.MaxNumNodesOnOwner,
-		IntRandomizer:                arg.IntRandomizer,
+		OwnerDistribution:            arg.OwnerDistribution,
+		TopUpPolicy:                  arg.TopUpPolicy,
 	}
 
 	return generate.NewMixedStakingGenerator(argMixedStaking)
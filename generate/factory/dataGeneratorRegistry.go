@@ -0,0 +1,143 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+)
+
+// DataGeneratorConstructor creates a data generator out of the common arguments and the parameters of the generation
+// type, the text following the type name in the <type>[:<parameters>] description
+type DataGeneratorConstructor func(arg ArgDataGenerator, parameters string) (DataGenerator, error)
+
+// DataGeneratorDefinition describes a named data generator. The parameters usage is shown next to the type name, a
+// generation type without it accepting no parameters
+type DataGeneratorDefinition struct {
+	Name            string
+	Description     string
+	ParametersUsage string
+	Constructor     DataGeneratorConstructor
+}
+
+type dataGeneratorRegistry struct {
+	mut         sync.RWMutex
+	definitions map[string]DataGeneratorDefinition
+	names       []string
+}
+
+var registry = createBuiltinRegistry()
+
+func createBuiltinRegistry() *dataGeneratorRegistry {
+	builtinRegistry := &dataGeneratorRegistry{
+		definitions: make(map[string]DataGeneratorDefinition),
+		names:       make([]string, 0),
+	}
+
+	builtinDefinitions := []DataGeneratorDefinition{
+		{
+			Name:        core.StakedType,
+			Description: "the nodes are staked directly by their owners",
+			Constructor: stakedTypeDataGenerator,
+		},
+		{
+			Name:        core.DelegatedStakeType,
+			Description: "the nodes are staked through the delegation contracts of the delegation providers",
+			Constructor: delegatedTypeDataGenerator,
+		},
+		{
+			Name:        core.MixedType,
+			Description: "the delegated nodes are staked through delegation, the other nodes directly",
+			Constructor: mixedTypeDataGenerator,
+		},
+	}
+	for _, definition := range builtinDefinitions {
+		_ = builtinRegistry.register(definition)
+	}
+
+	return builtinRegistry
+}
+
+// RegisterDataGenerator will register a named data generator, making its name a valid stake type. It is meant to be
+// called from the init function of the packages defining custom genesis shapes
+func RegisterDataGenerator(definition DataGeneratorDefinition) error {
+	return registry.register(definition)
+}
+
+// RegisteredDataGenerators returns the definitions of the registered data generators, in their registration order
+func RegisteredDataGenerators() []DataGeneratorDefinition {
+	return registry.registeredDefinitions()
+}
+
+// CheckGenerationType will check that the provided <type>[:<parameters>] description refers a registered data
+// generator, parameters being allowed only for the generation types accepting them
+func CheckGenerationType(description string) error {
+	_, _, err := registry.get(description)
+
+	return err
+}
+
+func (reg *dataGeneratorRegistry) register(definition DataGeneratorDefinition) error {
+	name := strings.TrimSpace(definition.Name)
+	if len(name) == 0 || strings.Contains(name, parametersSeparator) {
+		return fmt.Errorf("%w: %q", ErrInvalidGenerationTypeName, definition.Name)
+	}
+	if definition.Constructor == nil {
+		return fmt.Errorf("%w for %s", ErrNilDataGeneratorConstructor, name)
+	}
+
+	reg.mut.Lock()
+	defer reg.mut.Unlock()
+
+	_, found := reg.definitions[name]
+	if found {
+		return fmt.Errorf("%w: %s", ErrGenerationTypeAlreadyRegistered, name)
+	}
+
+	definition.Name = name
+	reg.definitions[name] = definition
+	reg.names = append(reg.names, name)
+
+	return nil
+}
+
+func (reg *dataGeneratorRegistry) get(description string) (DataGeneratorDefinition, string, error) {
+	name, parameters, hasParameters := strings.Cut(strings.TrimSpace(description), parametersSeparator)
+
+	reg.mut.RLock()
+	definition, found := reg.definitions[name]
+	reg.mut.RUnlock()
+	if !found {
+		return DataGeneratorDefinition{}, "", fmt.Errorf("%w: %s, the registered types are %s",
+			ErrUnknownGenerationType, description, strings.Join(reg.registeredNames(), ", "))
+	}
+	if hasParameters && len(definition.ParametersUsage) == 0 {
+		return DataGeneratorDefinition{}, "", fmt.Errorf("%w for %s, the %s type accepts no parameters",
+			ErrInvalidGenerationParameters, description, name)
+	}
+
+	return definition, parameters, nil
+}
+
+func (reg *dataGeneratorRegistry) registeredDefinitions() []DataGeneratorDefinition {
+	reg.mut.RLock()
+	defer reg.mut.RUnlock()
+
+	definitions := make([]DataGeneratorDefinition, 0, len(reg.names))
+	for _, name := range reg.names {
+		definitions = append(definitions, reg.definitions[name])
+	}
+
+	return definitions
+}
+
+func (reg *dataGeneratorRegistry) registeredNames() []string {
+	reg.mut.RLock()
+	defer reg.mut.RUnlock()
+
+	names := make([]string, len(reg.names))
+	copy(names, reg.names)
+
+	return names
+}
@@ -0,0 +1,151 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenerationType = "test-type"
+
+func createTestDataGeneratorDefinition(name string, parametersUsage string) DataGeneratorDefinition {
+	return DataGeneratorDefinition{
+		Name:            name,
+		Description:     "test description",
+		ParametersUsage: parametersUsage,
+		Constructor: func(arg ArgDataGenerator, parameters string) (DataGenerator, error) {
+			return nil, nil
+		},
+	}
+}
+
+func TestDataGeneratorRegistry_Register(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		err := reg.register(createTestDataGeneratorDefinition("  ", ""))
+		assert.True(t, errors.Is(err, ErrInvalidGenerationTypeName))
+	})
+	t.Run("name containing the parameters separator should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		err := reg.register(createTestDataGeneratorDefinition(testGenerationType+":3", ""))
+		assert.True(t, errors.Is(err, ErrInvalidGenerationTypeName))
+	})
+	t.Run("nil constructor should error", func(t *testing.T) {
+		t.Parallel()
+
+		definition := createTestDataGeneratorDefinition(testGenerationType, "")
+		definition.Constructor = nil
+
+		reg := createBuiltinRegistry()
+		err := reg.register(definition)
+		assert.True(t, errors.Is(err, ErrNilDataGeneratorConstructor))
+	})
+	t.Run("builtin name should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		err := reg.register(createTestDataGeneratorDefinition(core.DelegatedStakeType, ""))
+		assert.True(t, errors.Is(err, ErrGenerationTypeAlreadyRegistered))
+	})
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		err := reg.register(createTestDataGeneratorDefinition(testGenerationType, ""))
+		require.Nil(t, err)
+
+		err = reg.register(createTestDataGeneratorDefinition(" "+testGenerationType+" ", ""))
+		assert.True(t, errors.Is(err, ErrGenerationTypeAlreadyRegistered))
+		assert.Equal(t, 4, len(reg.registeredNames()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		err := reg.register(createTestDataGeneratorDefinition(" "+testGenerationType+" ", ""))
+		require.Nil(t, err)
+
+		definition, parameters, err := reg.get(testGenerationType)
+		require.Nil(t, err)
+		assert.Equal(t, testGenerationType, definition.Name)
+		assert.Empty(t, parameters)
+	})
+}
+
+func TestDataGeneratorRegistry_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		definition, parameters, err := reg.get(testGenerationType)
+		assert.True(t, errors.Is(err, ErrUnknownGenerationType))
+		assert.Contains(t, err.Error(), core.StakedType+", "+core.DelegatedStakeType+", "+core.MixedType)
+		assert.Empty(t, definition.Name)
+		assert.Empty(t, parameters)
+	})
+	t.Run("parameters on a type accepting none should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		_, _, err := reg.get(core.StakedType + ":3")
+		assert.True(t, errors.Is(err, ErrInvalidGenerationParameters))
+	})
+	t.Run("parameters should be split from the type name", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinRegistry()
+		err := reg.register(createTestDataGeneratorDefinition(testGenerationType, "numNodesOnOwner"))
+		require.Nil(t, err)
+
+		definition, parameters, err := reg.get(" " + testGenerationType + ":3:extra ")
+		require.Nil(t, err)
+		assert.Equal(t, testGenerationType, definition.Name)
+		assert.Equal(t, "3:extra", parameters)
+
+		definition, parameters, err = reg.get(testGenerationType + ":")
+		require.Nil(t, err)
+		assert.Equal(t, testGenerationType, definition.Name)
+		assert.Empty(t, parameters)
+	})
+}
+
+func TestDataGeneratorRegistry_RegisteredDefinitionsShouldKeepTheRegistrationOrder(t *testing.T) {
+	t.Parallel()
+
+	reg := createBuiltinRegistry()
+	err := reg.register(createTestDataGeneratorDefinition(testGenerationType, "numNodesOnOwner"))
+	require.Nil(t, err)
+
+	definitions := reg.registeredDefinitions()
+	require.Equal(t, 4, len(definitions))
+	assert.Equal(t, core.StakedType, definitions[0].Name)
+	assert.Equal(t, core.DelegatedStakeType, definitions[1].Name)
+	assert.Equal(t, core.MixedType, definitions[2].Name)
+	assert.Equal(t, testGenerationType, definitions[3].Name)
+	assert.Equal(t, "numNodesOnOwner", definitions[3].ParametersUsage)
+
+	// the builtin registry used by the package functions is left untouched
+	assert.Equal(t, 3, len(RegisteredDataGenerators()))
+	assert.True(t, errors.Is(CheckGenerationType(testGenerationType), ErrUnknownGenerationType))
+}
+
+func TestCheckGenerationType(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, CheckGenerationType(core.StakedType))
+	assert.Nil(t, CheckGenerationType(core.DelegatedStakeType))
+	assert.Nil(t, CheckGenerationType(core.MixedType))
+	assert.True(t, errors.Is(CheckGenerationType(""), ErrUnknownGenerationType))
+	assert.True(t, errors.Is(CheckGenerationType(core.MixedType+":1"), ErrInvalidGenerationParameters))
+}
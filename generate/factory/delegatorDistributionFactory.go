@@ -0,0 +1,66 @@
+package factory
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/generate"
+)
+
+// defaultParetoAlpha is the shape that produces the 80/20 rule
+const defaultParetoAlpha = 1.16
+
+// CreateDelegatorDistribution will create the delegator distribution out of its description, formatted as
+// <type>[:<parameters>]. The values are amounts converted using the provided denomination. The supported
+// descriptions are:
+//   - equal - all delegators delegate the same value
+//   - random:max - each delegator delegates a random value in the [minDelegation, max] interval
+//   - pareto[:alpha] - the values on top of the minimum delegation follow a Pareto distribution (default 1.16)
+//   - list:path - the delegated values are loaded from the provided file, one value per line
+func CreateDelegatorDistribution(
+	description string,
+	minDelegation *big.Int,
+	denomination int,
+	randomizer generate.IntRandomizer,
+) (generate.DelegatorDistribution, error) {
+	distributionType, parameters, hasParameters := strings.Cut(strings.TrimSpace(description), parametersSeparator)
+
+	switch distributionType {
+	case core.EqualDelegatorDistribution:
+		if hasParameters {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidDelegatorDistributionParameters, description)
+		}
+
+		return generate.NewEqualDelegatorDistribution(minDelegation)
+	case core.RandomDelegatorDistribution:
+		maxDelegation, err := core.ConvertAmount(parameters, denomination)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidDelegatorDistributionParameters, description, err.Error())
+		}
+
+		return generate.NewRandomDelegatorDistribution(minDelegation, maxDelegation, randomizer)
+	case core.ParetoDelegatorDistribution:
+		alpha := defaultParetoAlpha
+		if hasParameters {
+			var err error
+			alpha, err = strconv.ParseFloat(parameters, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w for %s: %s", ErrInvalidDelegatorDistributionParameters, description, err.Error())
+			}
+		}
+
+		return generate.NewParetoDelegatorDistribution(minDelegation, alpha, randomizer)
+	case core.ListDelegatorDistribution:
+		values, err := core.LoadDelegatedValues(parameters, denomination)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the delegated values from %s", err, parameters)
+		}
+
+		return generate.NewListDelegatorDistribution(values, minDelegation)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDelegatorDistribution, description)
+	}
+}
@@ -4,3 +4,42 @@ import "errors"
 
 // ErrUnknownGenerationType signals that an unknown data generation type was provided
 var ErrUnknownGenerationType = errors.New("unknown data generation type")
+
+// ErrInvalidGenerationParameters signals that parameters were provided to a data generation type accepting none
+var ErrInvalidGenerationParameters = errors.New("invalid data generation parameters")
+
+// ErrInvalidGenerationTypeName signals that a data generator was registered with an empty or malformed name
+var ErrInvalidGenerationTypeName = errors.New("invalid data generation type name")
+
+// ErrNilDataGeneratorConstructor signals that a data generator was registered without a constructor
+var ErrNilDataGeneratorConstructor = errors.New("nil data generator constructor")
+
+// ErrGenerationTypeAlreadyRegistered signals that a data generator was already registered under the same name
+var ErrGenerationTypeAlreadyRegistered = errors.New("data generation type already registered")
+
+// ErrUnknownOwnerDistribution signals that an unknown owner distribution was provided
+var ErrUnknownOwnerDistribution = errors.New("unknown owner distribution")
+
+// ErrInvalidOwnerDistributionParameters signals that the owner distribution parameters could not be parsed
+var ErrInvalidOwnerDistributionParameters = errors.New("invalid owner distribution parameters")
+
+// ErrUnknownDelegatorDistribution signals that an unknown delegator distribution was provided
+var ErrUnknownDelegatorDistribution = errors.New("unknown delegator distribution")
+
+// ErrInvalidDelegatorDistributionParameters signals that the delegator distribution parameters could not be parsed
+var ErrInvalidDelegatorDistributionParameters = errors.New("invalid delegator distribution parameters")
+
+// ErrUnknownTopUpPolicy signals that an unknown top-up policy was provided
+var ErrUnknownTopUpPolicy = errors.New("unknown top-up policy")
+
+// ErrInvalidTopUpPolicyParameters signals that the top-up policy parameters could not be parsed
+var ErrInvalidTopUpPolicyParameters = errors.New("invalid top-up policy parameters")
+
+// ErrUnknownRatingProfile signals that an unknown rating profile was provided
+var ErrUnknownRatingProfile = errors.New("unknown rating profile")
+
+// ErrInvalidRatingProfileParameters signals that the rating profile parameters could not be parsed
+var ErrInvalidRatingProfileParameters = errors.New("invalid rating profile parameters")
+
+// ErrInvalidRatingOverrides signals that the rating overrides could not be parsed
+var ErrInvalidRatingOverrides = errors.New("invalid rating overrides")
@@ -0,0 +1,85 @@
+package factory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/generate"
+)
+
+const (
+	parametersSeparator = ":"
+	listSeparator       = ","
+	defaultZipfExponent = 1.0
+)
+
+// CreateOwnerDistribution will create the owner distribution out of its description, formatted as
+// <type>[:<parameters>]. The supported descriptions are:
+//   - fixed[:N] - every owner holds N nodes (default maxNumNodesOnOwner)
+//   - uniform - every owner holds a random number of nodes in the [1, maxNumNodesOnOwner] interval
+//   - zipf[:exponent] - power-law distribution of the owner sizes in the [1, maxNumNodesOnOwner] interval (default 1.0)
+//   - list:n1,n2,... - the owners hold the provided number of nodes, the remaining nodes being held by solo owners
+func CreateOwnerDistribution(
+	description string,
+	maxNumNodesOnOwner uint,
+	randomizer generate.IntRandomizer,
+) (generate.OwnerDistribution, error) {
+	distributionType, parameters, hasParameters := strings.Cut(strings.TrimSpace(description), parametersSeparator)
+
+	switch distributionType {
+	case core.FixedOwnerDistribution:
+		numNodesOnOwner := maxNumNodesOnOwner
+		if hasParameters {
+			value, err := strconv.ParseUint(parameters, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w for %s: %s", ErrInvalidOwnerDistributionParameters, description, err.Error())
+			}
+			numNodesOnOwner = uint(value)
+		}
+
+		return generate.NewFixedOwnerDistribution(numNodesOnOwner)
+	case core.UniformOwnerDistribution:
+		if hasParameters {
+			return nil, fmt.Errorf("%w for %s, the maximum is given by the max-num-validators-per-node option",
+				ErrInvalidOwnerDistributionParameters, description)
+		}
+
+		return generate.NewUniformOwnerDistribution(maxNumNodesOnOwner, randomizer)
+	case core.ZipfOwnerDistribution:
+		exponent := defaultZipfExponent
+		if hasParameters {
+			var err error
+			exponent, err = strconv.ParseFloat(parameters, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w for %s: %s", ErrInvalidOwnerDistributionParameters, description, err.Error())
+			}
+		}
+
+		return generate.NewZipfOwnerDistribution(maxNumNodesOnOwner, exponent, randomizer)
+	case core.ListOwnerDistribution:
+		sizes, err := parseOwnerSizes(parameters)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidOwnerDistributionParameters, description, err.Error())
+		}
+
+		return generate.NewListOwnerDistribution(sizes)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOwnerDistribution, description)
+	}
+}
+
+func parseOwnerSizes(parameters string) ([]uint, error) {
+	sizes := make([]uint, 0)
+	for _, sizeString := range strings.Split(parameters, listSeparator) {
+		size, err := strconv.ParseUint(strings.TrimSpace(sizeString), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		sizes = append(sizes, uint(size))
+	}
+
+	return sizes, nil
+}
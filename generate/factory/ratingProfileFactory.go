@@ -0,0 +1,113 @@
+package factory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/generate"
+)
+
+// CreateRatingProfile will create the rating profile out of its description, formatted as <type>[:<parameters>],
+// and the owner overrides, formatted as <address>:<rating>,<address>:<rating>,...
+// The supported descriptions are:
+//   - fixed[:rating] - every node starts with the provided rating (default initialRating)
+//   - random:min,max - every node starts with a random rating in the [min, max] interval
+func CreateRatingProfile(
+	description string,
+	overrides string,
+	initialRating uint64,
+	randomizer generate.IntRandomizer,
+	walletPubKeyConverter mxCore.PubkeyConverter,
+) (generate.RatingProfile, error) {
+	defaultProfile, err := createDefaultRatingProfile(description, initialRating, randomizer)
+	if err != nil {
+		return nil, err
+	}
+
+	overridesMap, err := parseRatingOverrides(overrides, walletPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	if len(overridesMap) == 0 {
+		return defaultProfile, nil
+	}
+
+	return generate.NewOwnerOverridesRatingProfile(defaultProfile, overridesMap)
+}
+
+func createDefaultRatingProfile(
+	description string,
+	initialRating uint64,
+	randomizer generate.IntRandomizer,
+) (generate.RatingProfile, error) {
+	profileType, parameters, hasParameters := strings.Cut(strings.TrimSpace(description), parametersSeparator)
+
+	switch profileType {
+	case core.FixedRatingProfile:
+		rating := initialRating
+		if hasParameters {
+			var err error
+			rating, err = strconv.ParseUint(parameters, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w for %s: %s", ErrInvalidRatingProfileParameters, description, err.Error())
+			}
+		}
+		if rating > uint64(^uint32(0)) {
+			return nil, fmt.Errorf("%w, the initial rating %d does not fit on 32 bits",
+				ErrInvalidRatingProfileParameters, rating)
+		}
+
+		return generate.NewFixedRatingProfile(uint32(rating))
+	case core.RandomRatingProfile:
+		minString, maxString, found := strings.Cut(parameters, listSeparator)
+		if !found {
+			return nil, fmt.Errorf("%w for %s, expected random:min,max", ErrInvalidRatingProfileParameters, description)
+		}
+		minRating, err := strconv.ParseUint(strings.TrimSpace(minString), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidRatingProfileParameters, description, err.Error())
+		}
+		maxRating, err := strconv.ParseUint(strings.TrimSpace(maxString), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidRatingProfileParameters, description, err.Error())
+		}
+
+		return generate.NewRandomRatingProfile(uint32(minRating), uint32(maxRating), randomizer)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRatingProfile, description)
+	}
+}
+
+func parseRatingOverrides(overrides string, walletPubKeyConverter mxCore.PubkeyConverter) (map[string]uint32, error) {
+	overridesMap := make(map[string]uint32)
+	overrides = strings.TrimSpace(overrides)
+	if len(overrides) == 0 {
+		return overridesMap, nil
+	}
+
+	for _, override := range strings.Split(overrides, listSeparator) {
+		address, ratingString, found := strings.Cut(strings.TrimSpace(override), parametersSeparator)
+		if !found {
+			return nil, fmt.Errorf("%w for %s, expected address:rating", ErrInvalidRatingOverrides, override)
+		}
+		ownerPubKeyBytes, err := walletPubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidRatingOverrides, override, err.Error())
+		}
+		rating, err := strconv.ParseUint(ratingString, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidRatingOverrides, override, err.Error())
+		}
+
+		_, exists := overridesMap[string(ownerPubKeyBytes)]
+		if exists {
+			return nil, fmt.Errorf("%w, duplicated address %s", ErrInvalidRatingOverrides, address)
+		}
+		overridesMap[string(ownerPubKeyBytes)] = uint32(rating)
+	}
+
+	return overridesMap, nil
+}
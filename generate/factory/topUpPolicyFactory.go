@@ -0,0 +1,65 @@
+package factory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/generate"
+)
+
+// CreateTopUpPolicy will create the top-up policy out of its description, formatted as <type>[:<parameters>].
+// The values are amounts converted using the provided denomination.
+// The supported descriptions are:
+//   - none - the owners stake exactly the price of their nodes
+//   - fixed:value - every owner stakes the provided value on top of its nodes price
+//   - random:min,max - every owner stakes a random value in the [min, max] interval on top of its nodes price
+//   - proportional:percent - every owner stakes the provided percent of its nodes price on top of it
+func CreateTopUpPolicy(
+	description string,
+	denomination int,
+	randomizer generate.IntRandomizer,
+) (generate.TopUpPolicy, error) {
+	policyType, parameters, hasParameters := strings.Cut(strings.TrimSpace(description), parametersSeparator)
+
+	switch policyType {
+	case core.NoneTopUpPolicy:
+		if hasParameters {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidTopUpPolicyParameters, description)
+		}
+
+		return generate.NewNoneTopUpPolicy(), nil
+	case core.FixedTopUpPolicy:
+		topUp, err := core.ConvertAmount(parameters, denomination)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidTopUpPolicyParameters, description, err.Error())
+		}
+
+		return generate.NewFixedTopUpPolicy(topUp)
+	case core.RandomTopUpPolicy:
+		minString, maxString, found := strings.Cut(parameters, listSeparator)
+		if !found {
+			return nil, fmt.Errorf("%w for %s, expected random:min,max", ErrInvalidTopUpPolicyParameters, description)
+		}
+		minTopUp, err := core.ConvertAmount(minString, denomination)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidTopUpPolicyParameters, description, err.Error())
+		}
+		maxTopUp, err := core.ConvertAmount(maxString, denomination)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidTopUpPolicyParameters, description, err.Error())
+		}
+
+		return generate.NewRandomTopUpPolicy(minTopUp, maxTopUp, randomizer)
+	case core.ProportionalTopUpPolicy:
+		percent, err := strconv.ParseUint(parameters, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", ErrInvalidTopUpPolicyParameters, description, err.Error())
+		}
+
+		return generate.NewProportionalTopUpPolicy(percent), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTopUpPolicy, description)
+	}
+}
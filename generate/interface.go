@@ -1,7 +1,55 @@
 package generate
 
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
 // IntRandomizer interface provides functionality over generating integer numbers
 type IntRandomizer interface {
 	Intn(n int) int
 	IsInterfaceNil() bool
 }
+
+// OwnerDistribution defines how many validators each generated owner will hold
+type OwnerDistribution interface {
+	ComputeOwnerSizes(numNodes int) ([]int, error)
+	IsInterfaceNil() bool
+}
+
+// DelegatorDistribution defines the value delegated by each generated delegator
+type DelegatorDistribution interface {
+	ComputeDelegatedValues(totalDelegated *big.Int, numDelegators int) ([]*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// delegatorDistributionSplitter is implemented by the delegator distributions holding the values of all the
+// delegators, which have to be split, in order, among the delegation providers
+type delegatorDistributionSplitter interface {
+	SplitForProviders(providersNumDelegators []int) ([]DelegatorDistribution, error)
+}
+
+// TopUpPolicy defines the value each generated owner stakes on top of the price of its nodes
+type TopUpPolicy interface {
+	ComputeTopUp(nodesPrice *big.Int) *big.Int
+	IsInterfaceNil() bool
+}
+
+// RatingProfile defines the initial rating of each generated node. The owner is the address holding the node in the
+// nodes setup: the owner's wallet for the directly staked nodes and the delegation contract for the delegated ones
+type RatingProfile interface {
+	ComputeRating(ownerPubKeyBytes []byte) uint32
+	IsInterfaceNil() bool
+}
+
+// BalancePolicy defines how the genesis balance left after staking and delegation is spread among the accounts
+type BalancePolicy interface {
+	DelegatorBalance() *big.Int
+	DistributeBalance(
+		balance *big.Int,
+		owners []*data.WalletKey,
+		additionalKeys []*data.WalletKey,
+	) (*data.WalletKey, error)
+	IsInterfaceNil() bool
+}
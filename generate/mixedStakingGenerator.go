@@ -12,8 +12,9 @@ import (
 
 type mixedStakingGenerator struct {
 	*delegatedBaseGenerator
-	numDelegatedNodes  uint
-	maxNumNodesOnOwner uint
+	numDelegatedNodes uint
+	ownerDistribution OwnerDistribution
+	topUpPolicy       TopUpPolicy
 }
 
 // NewMixedStakingGenerator will create a mixed (direct + delegated) staking generator
@@ -25,11 +26,15 @@ func NewMixedStakingGenerator(arg ArgMixedStakingGenerator) (*mixedStakingGenera
 	if arg.NumDelegatedNodes == 0 {
 		return nil, fmt.Errorf("%w for the NumDelegatedNodes", ErrInvalidValue)
 	}
-	if arg.MaxNumNodesOnOwner == 0 {
-		return nil, fmt.Errorf("%w for MaxNumNodesOnOwner", ErrInvalidValue)
+	if arg.NumDelegatedNodes > arg.NumValidatorBlsKeys {
+		return nil, fmt.Errorf("%w, number of delegated nodes: %d, number of validators: %d",
+			ErrInvalidNumberOfDelegatedNodes, arg.NumDelegatedNodes, arg.NumValidatorBlsKeys)
 	}
-	if check.IfNil(arg.IntRandomizer) {
-		return nil, ErrNilRandomizer
+	if check.IfNil(arg.OwnerDistribution) {
+		return nil, ErrNilOwnerDistribution
+	}
+	if check.IfNil(arg.TopUpPolicy) {
+		return nil, ErrNilTopUpPolicy
 	}
 
 	msg := &mixedStakingGenerator{
@@ -37,19 +42,21 @@ func NewMixedStakingGenerator(arg ArgMixedStakingGenerator) (*mixedStakingGenera
 			baseGenerator: &baseGenerator{
 				numValidatorBlsKeys:      arg.NumValidatorBlsKeys,
 				numObserverBlsKeys:       arg.NumObserverBlsKeys,
-				richestAccountMode:       arg.RichestAccountMode,
+				balancePolicy:            arg.BalancePolicy,
 				numAdditionalWalletKeys:  arg.NumAdditionalWalletKeys,
 				totalSupply:              arg.TotalSupply,
 				walletPubKeyConverter:    arg.WalletPubKeyConverter,
 				validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+				ratingProfile:            arg.RatingProfile,
+				importedValidatorBlsKeys: arg.ImportedValidatorBlsKeys,
 			},
-			numDelegators: arg.NumDelegators,
 		},
-		numDelegatedNodes:  arg.NumDelegatedNodes,
-		maxNumNodesOnOwner: arg.MaxNumNodesOnOwner,
+		numDelegatedNodes: arg.NumDelegatedNodes,
+		ownerDistribution: arg.OwnerDistribution,
+		topUpPolicy:       arg.TopUpPolicy,
 	}
 
-	err = msg.prepareFieldsFromArguments(arg.ArgDelegatedStakingGenerator, arg.IntRandomizer)
+	err = msg.prepareFieldsFromArguments(arg.ArgDelegatedStakingGenerator, int(arg.NumDelegatedNodes))
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +71,7 @@ func (msg *mixedStakingGenerator) Generate() (*data.GeneratorOutput, error) {
 		return nil, err
 	}
 
-	delegators, err := msg.wkg.GenerateAdditionalKeys(int(msg.numDelegators))
+	delegators, delegatedUsedBalance, err := msg.generateDelegators()
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +81,6 @@ func (msg *mixedStakingGenerator) Generate() (*data.GeneratorOutput, error) {
 		return nil, err
 	}
 
-	delegatedUsedBalance := msg.prepareDelegators(delegators, int(msg.numDelegatedNodes))
 	walletKeys, stakedUsedBalance, err := msg.generateWalletKeys(validatorBlsKeys)
 	if err != nil {
 		return nil, err
@@ -87,26 +93,21 @@ func (msg *mixedStakingGenerator) Generate() (*data.GeneratorOutput, error) {
 			msg.totalSupply.String(), usedBalance.String())
 	}
 
-	walletBalance, remainder := msg.computeWalletBalance(len(walletKeys)+len(additionalKeys), balance)
-	for i, key := range walletKeys {
-		key.Balance = big.NewInt(0).Set(walletBalance)
-		if i == 0 {
-			key.Balance.Add(key.Balance, remainder)
-		}
-	}
-
-	for _, key := range additionalKeys {
-		key.Balance = big.NewInt(0).Set(walletBalance)
+	treasury, err := msg.balancePolicy.DistributeBalance(balance, walletKeys, additionalKeys)
+	if err != nil {
+		return nil, err
 	}
 
 	gen := &data.GeneratorOutput{
-		ValidatorBlsKeys: validatorBlsKeys,
-		ObserverBlsKeys:  observerBlsKeys,
-		WalletKeys:       walletKeys,
-		AdditionalKeys:   additionalKeys,
-		DelegatorKeys:    delegators,
+		ValidatorBlsKeys:    validatorBlsKeys,
+		ObserverBlsKeys:     observerBlsKeys,
+		WalletKeys:          walletKeys,
+		AdditionalKeys:      additionalKeys,
+		DelegatorKeys:       delegators,
+		DelegationContracts: msg.computeDelegationContracts(),
 	}
 	gen.InitialAccounts = msg.computeInitialAccounts(walletKeys, additionalKeys, delegators)
+	gen.InitialAccounts = msg.appendTreasuryAccount(gen.InitialAccounts, treasury)
 	gen.InitialNodes = msg.computeInitialNodes(validatorBlsKeys, walletKeys)
 
 	return gen, nil
@@ -115,13 +116,15 @@ func (msg *mixedStakingGenerator) Generate() (*data.GeneratorOutput, error) {
 func (msg *mixedStakingGenerator) generateWalletKeys(validatorBlsKeys []*data.BlsKey) ([]*data.WalletKey, *big.Int, error) {
 	// the first msg.numDelegatedNodes are considered delegated. The rest are considered staked
 	stakedNodes := validatorBlsKeys[msg.numDelegatedNodes:]
-	walletKeys, err := msg.wkg.GenerateKeys(stakedNodes, int(msg.maxNumNodesOnOwner))
+	walletKeys, err := msg.wkg.GenerateKeys(stakedNodes, msg.ownerDistribution, msg.topUpPolicy)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	stakedValue := big.NewInt(int64(len(stakedNodes)))
-	stakedValue.Mul(stakedValue, msg.wkg.NodePrice())
+	stakedValue := big.NewInt(0)
+	for _, key := range walletKeys {
+		stakedValue.Add(stakedValue, key.StakedValue)
+	}
 
 	return walletKeys, stakedValue, nil
 }
@@ -192,17 +195,7 @@ func (msg *mixedStakingGenerator) computeInitialNodes(validators []*data.BlsKey,
 	initialNodes := make([]*sharding.InitialNode, 0, len(validators))
 
 	// delegated nodes
-	for i := uint(0); i < msg.numDelegatedNodes; i++ {
-		blsKey := validators[i]
-		nodeAddress, _ := msg.validatorPubKeyConverter.Encode(blsKey.PubKeyBytes)
-
-		initialNode := &sharding.InitialNode{
-			PubKey:        nodeAddress,
-			Address:       msg.delegationScPkString,
-			InitialRating: msg.initialRating,
-		}
-		initialNodes = append(initialNodes, initialNode)
-	}
+	initialNodes = append(initialNodes, msg.computeDelegatedInitialNodes(validators[:msg.numDelegatedNodes])...)
 
 	// staked nodes
 	for _, key := range walletKeys {
@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -9,7 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-deploy-go/check"
-	"github.com/multiversx/mx-chain-deploy-go/mock"
+	"github.com/multiversx/mx-chain-deploy-go/core"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,14 +24,20 @@ func createMockMixedStakingGeneratorArguments() ArgMixedStakingGenerator {
 		KeyGeneratorForWallets:    signing.NewKeyGenerator(edSuite),
 		NumValidatorBlsKeys:       0,
 		NumObserverBlsKeys:        0,
-		RichestAccountMode:        false,
+		BalancePolicy:             createTestBalancePolicy(testMinimumBalance, false),
 		NumAdditionalWalletKeys:   0,
-		InitialRating:             50,
-		DelegationOwnerPkString:   "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
-		DelegationOwnerNonce:      0,
+		RatingProfile:             createTestRatingProfile(50),
 		VmType:                    "0500",
-		NumDelegators:             0,
+		DelegationProviders: []DelegationProvider{
+			{
+				OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+				OwnerNonce:    0,
+				NumNodes:      0,
+				NumDelegators: 0,
+			},
+		},
 	}
+	arg.DelegatorDistribution, _ = NewEqualDelegatorDistribution(big.NewInt(0))
 	arg.WalletPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
 	arg.ValidatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(96)
 	arg.TotalSupply = big.NewInt(0)
@@ -41,20 +48,40 @@ func createMockMixedStakingGeneratorArguments() ArgMixedStakingGenerator {
 	return ArgMixedStakingGenerator{
 		ArgDelegatedStakingGenerator: arg,
 		NumDelegatedNodes:            0,
-		MaxNumNodesOnOwner:           0,
-		IntRandomizer:                &mock.IntRandomizerStub{},
+		TopUpPolicy:                  NewNoneTopUpPolicy(),
 	}
 }
 
+func TestNewMixedStakingGenerator_TooManyDelegatedNodesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockMixedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 36
+	arg.NumDelegatedNodes = 500
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+	arg.DelegationProviders[0].NumNodes = 200
+	arg.DelegationProviders[0].NumDelegators = 1
+	arg.DelegationProviders = append(arg.DelegationProviders, DelegationProvider{
+		OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+		OwnerNonce:    1,
+		NumNodes:      300,
+		NumDelegators: 1,
+	})
+
+	msg, err := NewMixedStakingGenerator(arg)
+	assert.Nil(t, msg)
+	assert.True(t, errors.Is(err, ErrInvalidNumberOfDelegatedNodes))
+}
+
 func TestMixedStakingGenerator_GenerateShouldWork(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockMixedStakingGeneratorArguments()
 	arg.NumValidatorBlsKeys = 33
 	arg.NumObserverBlsKeys = 3
-	arg.NumDelegators = 47
+	arg.DelegationProviders[0].NumDelegators = 47
 	arg.NumDelegatedNodes = 9
-	arg.MaxNumNodesOnOwner = 1
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
 	arg.NumAdditionalWalletKeys = 3
 
 	msg, err := NewMixedStakingGenerator(arg)
@@ -65,15 +92,22 @@ func TestMixedStakingGenerator_GenerateShouldWork(t *testing.T) {
 
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.ValidatorBlsKeys))
 	assert.Equal(t, int(arg.NumObserverBlsKeys), len(generatedOutput.ObserverBlsKeys))
-	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.NumDelegators - arg.NumDelegatedNodes
+	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.DelegationProviders[0].NumDelegators - arg.NumDelegatedNodes
 	assert.Equal(t, int(expectedNumInitialAccounts), len(generatedOutput.InitialAccounts))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys-arg.NumDelegatedNodes), len(generatedOutput.WalletKeys))
 	assert.Equal(t, int(arg.NumAdditionalWalletKeys), len(generatedOutput.AdditionalKeys))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
-	assert.Equal(t, int(arg.NumDelegators), len(generatedOutput.DelegatorKeys))
+	assert.Equal(t, int(arg.DelegationProviders[0].NumDelegators), len(generatedOutput.DelegatorKeys))
 
-	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply)
-	assert.Nil(t, err, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
+
+	goc, _ := check.NewGeneratorOutputChecker(check.ArgGeneratorOutputChecker{
+		NodePrice:                arg.NodePrice,
+		WalletPubKeyConverter:    arg.WalletPubKeyConverter,
+		ValidatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+	})
+	assert.Nil(t, goc.CheckGeneratorOutput(generatedOutput))
 }
 
 func TestMixedStakingGenerator_GenerateWithRichestAccountShouldWork(t *testing.T) {
@@ -82,11 +116,11 @@ func TestMixedStakingGenerator_GenerateWithRichestAccountShouldWork(t *testing.T
 	arg := createMockMixedStakingGeneratorArguments()
 	arg.NumValidatorBlsKeys = 33
 	arg.NumObserverBlsKeys = 3
-	arg.NumDelegators = 47
+	arg.DelegationProviders[0].NumDelegators = 47
 	arg.NumDelegatedNodes = 9
-	arg.MaxNumNodesOnOwner = 1
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
 	arg.NumAdditionalWalletKeys = 3
-	arg.RichestAccountMode = true
+	arg.BalancePolicy = createTestBalancePolicy(testMinimumBalance, true)
 
 	msg, err := NewMixedStakingGenerator(arg)
 	require.Nil(t, err)
@@ -96,20 +130,72 @@ func TestMixedStakingGenerator_GenerateWithRichestAccountShouldWork(t *testing.T
 
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.ValidatorBlsKeys))
 	assert.Equal(t, int(arg.NumObserverBlsKeys), len(generatedOutput.ObserverBlsKeys))
-	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.NumDelegators - arg.NumDelegatedNodes
+	expectedNumInitialAccounts := arg.NumValidatorBlsKeys + arg.NumObserverBlsKeys + arg.DelegationProviders[0].NumDelegators - arg.NumDelegatedNodes
 	assert.Equal(t, int(expectedNumInitialAccounts), len(generatedOutput.InitialAccounts))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys-arg.NumDelegatedNodes), len(generatedOutput.WalletKeys))
 	assert.Equal(t, int(arg.NumAdditionalWalletKeys), len(generatedOutput.AdditionalKeys))
 	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
-	assert.Equal(t, int(arg.NumDelegators), len(generatedOutput.DelegatorKeys))
+	assert.Equal(t, int(arg.DelegationProviders[0].NumDelegators), len(generatedOutput.DelegatorKeys))
 
-	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply)
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
 	assert.Nil(t, err, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
 	for i, ia := range generatedOutput.InitialAccounts {
-		if i == int(arg.NumDelegators) {
-			assert.NotEqual(t, minimumInitialBalance, ia.Balance)
+		if i == int(arg.DelegationProviders[0].NumDelegators) {
+			assert.NotEqual(t, testMinimumBalance, ia.Balance)
 		} else {
-			assert.Equal(t, minimumInitialBalance, ia.Balance)
+			assert.Equal(t, testMinimumBalance, ia.Balance)
+		}
+	}
+}
+
+func TestMixedStakingGenerator_GenerateWithMultipleProvidersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockMixedStakingGeneratorArguments()
+	arg.NumValidatorBlsKeys = 20
+	arg.NumDelegatedNodes = 9
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+	arg.DelegationProviders = []DelegationProvider{
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    0,
+			NumNodes:      4,
+			NumDelegators: 3,
+		},
+		{
+			OwnerPkString: "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80",
+			OwnerNonce:    1,
+			NumNodes:      5,
+			NumDelegators: 6,
+		},
+	}
+
+	msg, err := NewMixedStakingGenerator(arg)
+	require.Nil(t, err)
+
+	generatedOutput, err := msg.Generate()
+	require.Nil(t, err)
+
+	firstScAddress, _ := core.GenerateSCAddress(arg.DelegationProviders[0].OwnerPkString, 0, arg.VmType, arg.WalletPubKeyConverter)
+	secondScAddress, _ := core.GenerateSCAddress(arg.DelegationProviders[1].OwnerPkString, 1, arg.VmType, arg.WalletPubKeyConverter)
+
+	numNodesOnAddress := make(map[string]int)
+	for _, node := range generatedOutput.InitialNodes {
+		numNodesOnAddress[node.Address]++
+	}
+	assert.Equal(t, 4, numNodesOnAddress[firstScAddress])
+	assert.Equal(t, 5, numNodesOnAddress[secondScAddress])
+	assert.Equal(t, int(arg.NumValidatorBlsKeys), len(generatedOutput.InitialNodes))
+
+	numDelegatorsOnAddress := make(map[string]int)
+	for _, ia := range generatedOutput.InitialAccounts {
+		if ia.Delegation != nil && len(ia.Delegation.Address) > 0 {
+			numDelegatorsOnAddress[ia.Delegation.Address]++
 		}
 	}
+	assert.Equal(t, 3, numDelegatorsOnAddress[firstScAddress])
+	assert.Equal(t, 6, numDelegatorsOnAddress[secondScAddress])
+
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	assert.Nil(t, iac.CheckInitialAccounts(generatedOutput.InitialAccounts))
 }
@@ -0,0 +1,328 @@
+package generate
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+type networkExtender struct {
+	*baseGenerator
+	ownerDistribution OwnerDistribution
+	topUpPolicy       TopUpPolicy
+}
+
+// NewNetworkExtender will create a generator able to add validators and observers to an existing network. The added
+// validators are staked directly by newly generated owners
+func NewNetworkExtender(arg ArgNetworkExtender) (*networkExtender, error) {
+	if check.IfNil(arg.OwnerDistribution) {
+		return nil, ErrNilOwnerDistribution
+	}
+	if check.IfNil(arg.TopUpPolicy) {
+		return nil, ErrNilTopUpPolicy
+	}
+	if check.IfNil(arg.BalancePolicy) {
+		return nil, ErrNilBalancePolicy
+	}
+	if check.IfNil(arg.RatingProfile) {
+		return nil, ErrNilRatingProfile
+	}
+	if check.IfNil(arg.WalletPubKeyConverter) {
+		return nil, fmt.Errorf("%w for the WalletPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for the ValidatorPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if arg.TotalSupply == nil {
+		return nil, ErrNilTotalSupply
+	}
+
+	ne := &networkExtender{
+		baseGenerator: &baseGenerator{
+			numValidatorBlsKeys:      arg.NumValidatorBlsKeys,
+			numObserverBlsKeys:       arg.NumObserverBlsKeys,
+			balancePolicy:            arg.BalancePolicy,
+			totalSupply:              arg.TotalSupply,
+			walletPubKeyConverter:    arg.WalletPubKeyConverter,
+			validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+			ratingProfile:            arg.RatingProfile,
+		},
+		ownerDistribution: arg.OwnerDistribution,
+		topUpPolicy:       arg.TopUpPolicy,
+	}
+	var err error
+	ne.vkg, err = NewValidatorKeyGenerator(arg.KeyGeneratorForValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	ne.wkg, err = NewWalletKeyGenerator(arg.KeyGeneratorForWallets, arg.NodePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return ne, nil
+}
+
+// Extend will generate the validators and observers missing from the existing output, together with the owners of the
+// new validators. All the existing keys, nodes and accounts are preserved: the balances of the owners are the only
+// values changed, as the balance left after staking is distributed again between the owners under the balance policy.
+// The staked and delegated values, the delegators, the additional accounts and any other genesis account are kept as
+// they are
+func (ne *networkExtender) Extend(existing *data.GeneratorOutput) (*data.GeneratorOutput, error) {
+	if existing == nil {
+		return nil, ErrNilGeneratorOutput
+	}
+
+	newValidatorBlsKeys, newObserverBlsKeys, err := ne.generateMissingNodes(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	newWalletKeys, err := ne.wkg.GenerateKeys(newValidatorBlsKeys, ne.ownerDistribution, ne.topUpPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// the balances are distributed again, so the existing keys are copied to leave the provided output untouched
+	walletKeys := copyWalletKeys(existing.WalletKeys)
+	initialAccounts, err := ne.computeInitialAccounts(existing.InitialAccounts, walletKeys, newWalletKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	gen := &data.GeneratorOutput{
+		ValidatorBlsKeys:    append(copyBlsKeys(existing.ValidatorBlsKeys), newValidatorBlsKeys...),
+		ObserverBlsKeys:     append(copyBlsKeys(existing.ObserverBlsKeys), newObserverBlsKeys...),
+		WalletKeys:          append(walletKeys, newWalletKeys...),
+		AdditionalKeys:      existing.AdditionalKeys,
+		InitialAccounts:     initialAccounts,
+		InitialNodes:        make([]*sharding.InitialNode, 0, ne.numValidatorBlsKeys),
+		DelegatorKeys:       existing.DelegatorKeys,
+		DelegationContracts: existing.DelegationContracts,
+		Mnemonic:            existing.Mnemonic,
+	}
+	// the node assigns the initial nodes in the order they are defined, so the new nodes are appended to the existing ones
+	gen.InitialNodes = append(gen.InitialNodes, existing.InitialNodes...)
+	for _, key := range newWalletKeys {
+		gen.InitialNodes = append(gen.InitialNodes, ne.computeInitialNodesForWalletKey(key)...)
+	}
+
+	return gen, nil
+}
+
+func (ne *networkExtender) generateMissingNodes(
+	existing *data.GeneratorOutput,
+) ([]*data.BlsKey, []*data.BlsKey, error) {
+	numExistingValidators := uint(len(existing.InitialNodes))
+	if ne.numValidatorBlsKeys < numExistingValidators {
+		return nil, nil, fmt.Errorf("%w, existing validators: %d, requested validators: %d",
+			ErrCannotShrinkNetwork, numExistingValidators, ne.numValidatorBlsKeys)
+	}
+	numExistingObservers := uint(len(existing.ObserverBlsKeys))
+	if ne.numObserverBlsKeys < numExistingObservers {
+		return nil, nil, fmt.Errorf("%w, existing observers: %d, requested observers: %d",
+			ErrCannotShrinkNetwork, numExistingObservers, ne.numObserverBlsKeys)
+	}
+
+	newValidatorBlsKeys, err := ne.vkg.GenerateKeys(ne.numValidatorBlsKeys - numExistingValidators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newObserverBlsKeys, err := ne.vkg.GenerateKeys(ne.numObserverBlsKeys - numExistingObservers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allBlsKeys := make([]*data.BlsKey, 0, ne.numValidatorBlsKeys+ne.numObserverBlsKeys)
+	allBlsKeys = append(allBlsKeys, existing.ValidatorBlsKeys...)
+	allBlsKeys = append(allBlsKeys, existing.ObserverBlsKeys...)
+	allBlsKeys = append(allBlsKeys, newValidatorBlsKeys...)
+	allBlsKeys = append(allBlsKeys, newObserverBlsKeys...)
+	err = checkDuplicatedBlsKeys(allBlsKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newValidatorBlsKeys, newObserverBlsKeys, nil
+}
+
+// computeInitialAccounts will compute the initial accounts of the extended network: the existing accounts, in their
+// original order, followed by the accounts of the new owners and by the treasury account, if any
+func (ne *networkExtender) computeInitialAccounts(
+	initialAccounts []mxData.InitialAccount,
+	existingOwners []*data.WalletKey,
+	newWalletKeys []*data.WalletKey,
+) ([]mxData.InitialAccount, error) {
+	existingAccounts := make([]mxData.InitialAccount, 0, len(initialAccounts))
+	accountsIndexes := make(map[string]int, len(initialAccounts))
+	for i, account := range initialAccounts {
+		existingAccounts = append(existingAccounts, copyInitialAccount(account))
+		accountsIndexes[account.Address] = i
+	}
+
+	for _, key := range newWalletKeys {
+		address, _ := ne.walletPubKeyConverter.Encode(key.PubKeyBytes)
+		_, found := accountsIndexes[address]
+		if found {
+			return nil, fmt.Errorf("%w for address %s", ErrDuplicatedWalletKey, address)
+		}
+	}
+
+	rebalancedAccounts := make(map[string]*data.WalletKey, len(existingOwners))
+	for _, key := range existingOwners {
+		address, _ := ne.walletPubKeyConverter.Encode(key.PubKeyBytes)
+		_, found := accountsIndexes[address]
+		if !found {
+			return nil, fmt.Errorf("%w for address %s", ErrMissingInitialAccount, address)
+		}
+
+		rebalancedAccounts[address] = key
+	}
+
+	owners := make([]*data.WalletKey, 0, len(existingOwners)+len(newWalletKeys))
+	owners = append(owners, existingOwners...)
+	owners = append(owners, newWalletKeys...)
+	treasury, err := ne.distributeBalance(existingAccounts, rebalancedAccounts, newWalletKeys, owners)
+	if err != nil {
+		return nil, err
+	}
+	treasuryAddress := ""
+	if treasury != nil {
+		treasuryAddress, _ = ne.walletPubKeyConverter.Encode(treasury.PubKeyBytes)
+	}
+
+	extendedAccounts := make([]mxData.InitialAccount, 0, len(existingAccounts)+len(newWalletKeys)+1)
+	for _, account := range existingAccounts {
+		if account.Address == treasuryAddress {
+			continue
+		}
+
+		key, found := rebalancedAccounts[account.Address]
+		if found {
+			account.Balance = big.NewInt(0).Set(key.Balance)
+			account.Supply = big.NewInt(0).Add(account.Balance, account.StakingValue)
+			account.Supply.Add(account.Supply, account.Delegation.Value)
+		}
+
+		extendedAccounts = append(extendedAccounts, account)
+	}
+
+	for _, key := range newWalletKeys {
+		walletAddress, _ := ne.walletPubKeyConverter.Encode(key.PubKeyBytes)
+
+		account := mxData.InitialAccount{
+			Address:      walletAddress,
+			Supply:       big.NewInt(0).Add(key.Balance, key.StakedValue),
+			Balance:      big.NewInt(0).Set(key.Balance),
+			StakingValue: big.NewInt(0).Set(key.StakedValue),
+			Delegation: &mxData.DelegationData{
+				Address: "",
+				Value:   big.NewInt(0),
+			},
+		}
+
+		extendedAccounts = append(extendedAccounts, account)
+	}
+
+	return ne.appendTreasuryAccount(extendedAccounts, treasury), nil
+}
+
+// distributeBalance will distribute between the owners, under the balance policy, the total supply left after the kept
+// accounts, the staked and delegated values of the existing owners and the stake of the new owners
+func (ne *networkExtender) distributeBalance(
+	existingAccounts []mxData.InitialAccount,
+	rebalancedAccounts map[string]*data.WalletKey,
+	newWalletKeys []*data.WalletKey,
+	owners []*data.WalletKey,
+) (*data.WalletKey, error) {
+	usedBalance := big.NewInt(0)
+	keptSupplies := make(map[string]*big.Int)
+	for _, account := range existingAccounts {
+		_, found := rebalancedAccounts[account.Address]
+		if found {
+			usedBalance.Add(usedBalance, account.StakingValue)
+			usedBalance.Add(usedBalance, account.Delegation.Value)
+			continue
+		}
+
+		usedBalance.Add(usedBalance, account.Supply)
+		keptSupplies[account.Address] = account.Supply
+	}
+	for _, key := range newWalletKeys {
+		usedBalance.Add(usedBalance, key.StakedValue)
+	}
+
+	balance := big.NewInt(0).Sub(ne.totalSupply, usedBalance)
+	if balance.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w, total supply: %s, usedBalance: %s", ErrTotalSupplyTooSmall,
+			ne.totalSupply.String(), usedBalance.String())
+	}
+
+	treasury, err := ne.balancePolicy.DistributeBalance(balance, owners, nil)
+	if err != nil || treasury == nil {
+		return treasury, err
+	}
+
+	// the treasury account of the existing network is replaced, so its previous supply becomes available again
+	treasuryAddress, _ := ne.walletPubKeyConverter.Encode(treasury.PubKeyBytes)
+	previousTreasurySupply, found := keptSupplies[treasuryAddress]
+	if !found {
+		return treasury, nil
+	}
+
+	balance.Add(balance, previousTreasurySupply)
+
+	return ne.balancePolicy.DistributeBalance(balance, owners, nil)
+}
+
+func copyBlsKeys(keys []*data.BlsKey) []*data.BlsKey {
+	keysCopy := make([]*data.BlsKey, 0, len(keys))
+
+	return append(keysCopy, keys...)
+}
+
+func copyWalletKeys(keys []*data.WalletKey) []*data.WalletKey {
+	keysCopy := make([]*data.WalletKey, 0, len(keys))
+	for _, key := range keys {
+		keyCopy := *key
+		keysCopy = append(keysCopy, &keyCopy)
+	}
+
+	return keysCopy
+}
+
+// copyInitialAccount returns a deep copy of the provided account, the missing values being copied as zero values
+func copyInitialAccount(account mxData.InitialAccount) mxData.InitialAccount {
+	accountCopy := account
+	accountCopy.Supply = copyValue(account.Supply)
+	accountCopy.Balance = copyValue(account.Balance)
+	accountCopy.StakingValue = copyValue(account.StakingValue)
+	accountCopy.Delegation = &mxData.DelegationData{
+		Value: big.NewInt(0),
+	}
+	if account.Delegation != nil {
+		accountCopy.Delegation.Address = account.Delegation.Address
+		accountCopy.Delegation.Value = copyValue(account.Delegation.Value)
+	}
+
+	return accountCopy
+}
+
+func copyValue(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ne *networkExtender) IsInterfaceNil() bool {
+	return ne == nil
+}
@@ -0,0 +1,279 @@
+package generate
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-deploy-go/check"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockNetworkExtenderArguments() ArgNetworkExtender {
+	mclSuite := mcl.NewSuiteBLS12()
+	edSuite := ed25519.NewEd25519()
+
+	arg := ArgNetworkExtender{
+		KeyGeneratorForValidators: signing.NewKeyGenerator(mclSuite),
+		KeyGeneratorForWallets:    signing.NewKeyGenerator(edSuite),
+		NumValidatorBlsKeys:       0,
+		NumObserverBlsKeys:        0,
+		BalancePolicy:             createTestBalancePolicy(big.NewInt(0), false),
+		TopUpPolicy:               NewNoneTopUpPolicy(),
+		NodePrice:                 big.NewInt(2500),
+		TotalSupply:               big.NewInt(20000000),
+		RatingProfile:             createTestRatingProfile(50),
+	}
+	arg.OwnerDistribution, _ = NewFixedOwnerDistribution(1)
+	arg.WalletPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	arg.ValidatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(96)
+
+	return arg
+}
+
+func generateExistingNetwork(
+	t *testing.T,
+	arg ArgNetworkExtender,
+	numValidators uint,
+	numObservers uint,
+) *data.GeneratorOutput {
+	dsg, err := NewDirectStakingGenerator(ArgDirectStakingGenerator{
+		KeyGeneratorForValidators: arg.KeyGeneratorForValidators,
+		KeyGeneratorForWallets:    arg.KeyGeneratorForWallets,
+		WalletPubKeyConverter:     arg.WalletPubKeyConverter,
+		ValidatorPubKeyConverter:  arg.ValidatorPubKeyConverter,
+		NumValidatorBlsKeys:       numValidators,
+		NumObserverBlsKeys:        numObservers,
+		BalancePolicy:             arg.BalancePolicy,
+		OwnerDistribution:         arg.OwnerDistribution,
+		TopUpPolicy:               arg.TopUpPolicy,
+		NumAdditionalWalletKeys:   2,
+		NodePrice:                 arg.NodePrice,
+		TotalSupply:               arg.TotalSupply,
+		RatingProfile:             arg.RatingProfile,
+	})
+	require.Nil(t, err)
+
+	existing, err := dsg.Generate()
+	require.Nil(t, err)
+
+	return existing
+}
+
+func checkExtendedOutput(t *testing.T, arg ArgNetworkExtender, extended *data.GeneratorOutput) {
+	report := check.NewReport()
+	iac, _ := check.NewInitialAccountsChecker(arg.NodePrice, arg.TotalSupply, core.DefaultDenomination)
+	report.Merge(iac.ValidateInitialAccounts(extended.InitialAccounts))
+
+	goc, _ := check.NewGeneratorOutputChecker(check.ArgGeneratorOutputChecker{
+		NodePrice:                arg.NodePrice,
+		WalletPubKeyConverter:    arg.WalletPubKeyConverter,
+		ValidatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+	})
+	report.Merge(goc.ValidateGeneratorOutput(extended))
+	assert.True(t, report.IsValid(), report.String())
+}
+
+func TestNewNetworkExtender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil owner distribution should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockNetworkExtenderArguments()
+		arg.OwnerDistribution = nil
+
+		ne, err := NewNetworkExtender(arg)
+		assert.Nil(t, ne)
+		assert.Equal(t, ErrNilOwnerDistribution, err)
+	})
+	t.Run("nil balance policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockNetworkExtenderArguments()
+		arg.BalancePolicy = nil
+
+		ne, err := NewNetworkExtender(arg)
+		assert.Nil(t, ne)
+		assert.Equal(t, ErrNilBalancePolicy, err)
+	})
+	t.Run("nil total supply should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockNetworkExtenderArguments()
+		arg.TotalSupply = nil
+
+		ne, err := NewNetworkExtender(arg)
+		assert.Nil(t, ne)
+		assert.Equal(t, ErrNilTotalSupply, err)
+	})
+	t.Run("nil node price should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockNetworkExtenderArguments()
+		arg.NodePrice = nil
+
+		ne, err := NewNetworkExtender(arg)
+		assert.Nil(t, ne)
+		assert.Equal(t, ErrNilNodePrice, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ne, err := NewNetworkExtender(createMockNetworkExtenderArguments())
+		assert.Nil(t, err)
+		assert.NotNil(t, ne)
+	})
+}
+
+func TestNetworkExtender_ExtendNilOutputShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ne, _ := NewNetworkExtender(createMockNetworkExtenderArguments())
+	extended, err := ne.Extend(nil)
+	assert.Nil(t, extended)
+	assert.Equal(t, ErrNilGeneratorOutput, err)
+}
+
+func TestNetworkExtender_ExtendFewerNodesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockNetworkExtenderArguments()
+	existing := generateExistingNetwork(t, arg, 10, 2)
+
+	arg.NumValidatorBlsKeys = 9
+	arg.NumObserverBlsKeys = 2
+	ne, _ := NewNetworkExtender(arg)
+	extended, err := ne.Extend(existing)
+	assert.Nil(t, extended)
+	assert.True(t, errors.Is(err, ErrCannotShrinkNetwork))
+
+	arg.NumValidatorBlsKeys = 10
+	arg.NumObserverBlsKeys = 1
+	ne, _ = NewNetworkExtender(arg)
+	extended, err = ne.Extend(existing)
+	assert.Nil(t, extended)
+	assert.True(t, errors.Is(err, ErrCannotShrinkNetwork))
+}
+
+func TestNetworkExtender_ExtendShouldPreserveTheExistingNetwork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockNetworkExtenderArguments()
+	existing := generateExistingNetwork(t, arg, 10, 2)
+	existingBalance := big.NewInt(0).Set(existing.WalletKeys[0].Balance)
+
+	arg.NumValidatorBlsKeys = 14
+	arg.NumObserverBlsKeys = 3
+	ne, err := NewNetworkExtender(arg)
+	require.Nil(t, err)
+
+	extended, err := ne.Extend(existing)
+	require.Nil(t, err)
+
+	require.Equal(t, 14, len(extended.ValidatorBlsKeys))
+	require.Equal(t, 3, len(extended.ObserverBlsKeys))
+	require.Equal(t, 14, len(extended.InitialNodes))
+	require.Equal(t, 14, len(extended.WalletKeys))
+	require.Equal(t, 2, len(extended.AdditionalKeys))
+	require.Equal(t, 16, len(extended.InitialAccounts))
+	for i, key := range existing.ValidatorBlsKeys {
+		assert.Equal(t, key, extended.ValidatorBlsKeys[i])
+		assert.Equal(t, existing.InitialNodes[i], extended.InitialNodes[i])
+	}
+	for i, key := range existing.ObserverBlsKeys {
+		assert.Equal(t, key, extended.ObserverBlsKeys[i])
+	}
+	for i, account := range existing.InitialAccounts {
+		assert.Equal(t, account.Address, extended.InitialAccounts[i].Address)
+		assert.Equal(t, account.StakingValue, extended.InitialAccounts[i].StakingValue)
+	}
+	// the additional accounts keep their balances
+	assert.Equal(t, existing.InitialAccounts[10:12], extended.InitialAccounts[10:12])
+	for i, key := range extended.WalletKeys[10:] {
+		expectedPubKey, _ := arg.ValidatorPubKeyConverter.Encode(extended.ValidatorBlsKeys[10+i].PubKeyBytes)
+		expectedAddress, _ := arg.WalletPubKeyConverter.Encode(key.PubKeyBytes)
+		assert.Equal(t, expectedPubKey, extended.InitialNodes[10+i].PubKey)
+		assert.Equal(t, expectedAddress, extended.InitialNodes[10+i].Address)
+		assert.Equal(t, expectedAddress, extended.InitialAccounts[12+i].Address)
+	}
+	// the balances are distributed again without altering the provided output
+	assert.Equal(t, existingBalance, existing.WalletKeys[0].Balance)
+	assert.NotEqual(t, existingBalance, extended.WalletKeys[0].Balance)
+
+	checkExtendedOutput(t, arg, extended)
+}
+
+func TestNetworkExtender_ExtendWithTreasuryShouldReplaceTheTreasuryAccount(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockNetworkExtenderArguments()
+	treasuryAddress := "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80"
+	treasuryPkBytes, _ := arg.WalletPubKeyConverter.Decode(treasuryAddress)
+	arg.BalancePolicy, _ = NewBalancePolicy(ArgBalancePolicy{
+		MinOwnerBalance:      big.NewInt(0),
+		MinDelegatorBalance:  big.NewInt(0),
+		MinAdditionalBalance: big.NewInt(0),
+		RemainderSink:        core.TreasuryRemainderSink,
+		TreasuryPubKeyBytes:  treasuryPkBytes,
+		TreasuryPercent:      10,
+		TotalSupply:          arg.TotalSupply,
+	})
+	existing := generateExistingNetwork(t, arg, 10, 0)
+
+	arg.NumValidatorBlsKeys = 12
+	ne, _ := NewNetworkExtender(arg)
+	extended, err := ne.Extend(existing)
+	require.Nil(t, err)
+
+	require.Equal(t, 15, len(extended.InitialAccounts))
+	numTreasuryAccounts := 0
+	for _, account := range extended.InitialAccounts {
+		if account.Address == treasuryAddress {
+			numTreasuryAccounts++
+		}
+	}
+	assert.Equal(t, 1, numTreasuryAccounts)
+	treasuryAccount := extended.InitialAccounts[len(extended.InitialAccounts)-1]
+	assert.Equal(t, treasuryAddress, treasuryAccount.Address)
+	// 10% of the total supply plus the remainder of (20000000 - 2 * 1497916 - 12 * 2500 - 2000000) / 12, the additional
+	// accounts keeping their balance of (20000000 - 10 * 2500 - 2000000) / 12
+	assert.Equal(t, big.NewInt(2000004), treasuryAccount.Balance)
+
+	checkExtendedOutput(t, arg, extended)
+}
+
+func TestNetworkExtender_ExtendShouldKeepTheDelegators(t *testing.T) {
+	t.Parallel()
+
+	delegatedArg := createMockDelegatedStakingGeneratorArguments()
+	delegatedArg.NumValidatorBlsKeys = 5
+	delegatedArg.DelegationProviders[0].NumDelegators = 3
+	dsg, _ := NewDelegatedGenerator(delegatedArg)
+	existing, err := dsg.Generate()
+	require.Nil(t, err)
+
+	arg := createMockNetworkExtenderArguments()
+	arg.BalancePolicy = delegatedArg.BalancePolicy
+	arg.NodePrice = delegatedArg.NodePrice
+	arg.TotalSupply = delegatedArg.TotalSupply
+	arg.NumValidatorBlsKeys = 7
+	ne, _ := NewNetworkExtender(arg)
+	extended, err := ne.Extend(existing)
+	require.Nil(t, err)
+
+	assert.Equal(t, existing.DelegatorKeys, extended.DelegatorKeys)
+	assert.Equal(t, existing.DelegationContracts, extended.DelegationContracts)
+	for i := range existing.DelegatorKeys {
+		assert.Equal(t, existing.InitialAccounts[i], extended.InitialAccounts[i])
+	}
+
+	checkExtendedOutput(t, arg, extended)
+}
@@ -0,0 +1,202 @@
+package generate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// zipfWeightsScale is the weight of the single node owners in the zipf distribution, the rest being scaled accordingly
+const zipfWeightsScale = 1000000
+
+type fixedOwnerDistribution struct {
+	numNodesOnOwner int
+}
+
+// NewFixedOwnerDistribution will create an owner distribution in which every owner holds the same number of nodes.
+// The last owner might hold fewer nodes
+func NewFixedOwnerDistribution(numNodesOnOwner uint) (*fixedOwnerDistribution, error) {
+	if numNodesOnOwner == 0 {
+		return nil, fmt.Errorf("%w for numNodesOnOwner", ErrInvalidValue)
+	}
+
+	return &fixedOwnerDistribution{
+		numNodesOnOwner: int(numNodesOnOwner),
+	}, nil
+}
+
+// ComputeOwnerSizes returns the number of nodes held by each owner
+func (fod *fixedOwnerDistribution) ComputeOwnerSizes(numNodes int) ([]int, error) {
+	return computeOwnerSizes(numNodes, func() int {
+		return fod.numNodesOnOwner
+	}), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fod *fixedOwnerDistribution) IsInterfaceNil() bool {
+	return fod == nil
+}
+
+type uniformOwnerDistribution struct {
+	maxNumNodesOnOwner int
+	randomizer         IntRandomizer
+}
+
+// NewUniformOwnerDistribution will create an owner distribution in which each owner holds a random number of nodes,
+// uniformly chosen in the [1, maxNumNodesOnOwner] interval
+func NewUniformOwnerDistribution(maxNumNodesOnOwner uint, randomizer IntRandomizer) (*uniformOwnerDistribution, error) {
+	if maxNumNodesOnOwner == 0 {
+		return nil, fmt.Errorf("%w for maxNumNodesOnOwner", ErrInvalidValue)
+	}
+	if check.IfNil(randomizer) {
+		return nil, ErrNilRandomizer
+	}
+
+	return &uniformOwnerDistribution{
+		maxNumNodesOnOwner: int(maxNumNodesOnOwner),
+		randomizer:         randomizer,
+	}, nil
+}
+
+// ComputeOwnerSizes returns the number of nodes held by each owner
+func (uod *uniformOwnerDistribution) ComputeOwnerSizes(numNodes int) ([]int, error) {
+	return computeOwnerSizes(numNodes, func() int {
+		return uod.randomizer.Intn(uod.maxNumNodesOnOwner) + 1
+	}), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (uod *uniformOwnerDistribution) IsInterfaceNil() bool {
+	return uod == nil
+}
+
+type zipfOwnerDistribution struct {
+	cumulativeWeights []int
+	randomizer        IntRandomizer
+}
+
+// NewZipfOwnerDistribution will create a power-law owner distribution: the probability of an owner to hold k nodes is
+// proportional with 1/k^exponent, k being in the [1, maxNumNodesOnOwner] interval. This results in a few large
+// providers and a long tail of solo stakers
+func NewZipfOwnerDistribution(
+	maxNumNodesOnOwner uint,
+	exponent float64,
+	randomizer IntRandomizer,
+) (*zipfOwnerDistribution, error) {
+	if maxNumNodesOnOwner == 0 {
+		return nil, fmt.Errorf("%w for maxNumNodesOnOwner", ErrInvalidValue)
+	}
+	if exponent <= 0 || math.IsInf(exponent, 0) || math.IsNaN(exponent) {
+		return nil, fmt.Errorf("%w for exponent", ErrInvalidValue)
+	}
+	if check.IfNil(randomizer) {
+		return nil, ErrNilRandomizer
+	}
+
+	// integer weights are used so the same randomizer output will always produce the same sizes
+	cumulativeWeights := make([]int, 0, maxNumNodesOnOwner)
+	total := 0
+	for k := 1; k <= int(maxNumNodesOnOwner); k++ {
+		weight := int(math.Round(zipfWeightsScale / math.Pow(float64(k), exponent)))
+		if weight < 1 {
+			weight = 1
+		}
+		total += weight
+		cumulativeWeights = append(cumulativeWeights, total)
+	}
+
+	return &zipfOwnerDistribution{
+		cumulativeWeights: cumulativeWeights,
+		randomizer:        randomizer,
+	}, nil
+}
+
+// ComputeOwnerSizes returns the number of nodes held by each owner
+func (zod *zipfOwnerDistribution) ComputeOwnerSizes(numNodes int) ([]int, error) {
+	return computeOwnerSizes(numNodes, func() int {
+		value := zod.randomizer.Intn(zod.cumulativeWeights[len(zod.cumulativeWeights)-1])
+		for i, cumulativeWeight := range zod.cumulativeWeights {
+			if value < cumulativeWeight {
+				return i + 1
+			}
+		}
+
+		return len(zod.cumulativeWeights)
+	}), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (zod *zipfOwnerDistribution) IsInterfaceNil() bool {
+	return zod == nil
+}
+
+type listOwnerDistribution struct {
+	sizes []int
+}
+
+// NewListOwnerDistribution will create an owner distribution in which the owners hold the provided number of nodes, in
+// order. If the list holds fewer nodes than required, the remaining nodes will be held by single node owners
+func NewListOwnerDistribution(sizes []uint) (*listOwnerDistribution, error) {
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("%w, empty sizes list", ErrInvalidValue)
+	}
+
+	lod := &listOwnerDistribution{
+		sizes: make([]int, 0, len(sizes)),
+	}
+	for i, size := range sizes {
+		if size == 0 {
+			return nil, fmt.Errorf("%w for the size at index %d", ErrInvalidValue, i)
+		}
+
+		lod.sizes = append(lod.sizes, int(size))
+	}
+
+	return lod, nil
+}
+
+// ComputeOwnerSizes returns the number of nodes held by each owner. It errors if the list holds more nodes than the
+// provided number of nodes, as an explicit list is never truncated
+func (lod *listOwnerDistribution) ComputeOwnerSizes(numNodes int) ([]int, error) {
+	numListedNodes := 0
+	for _, size := range lod.sizes {
+		numListedNodes += size
+	}
+	if numListedNodes > numNodes {
+		return nil, fmt.Errorf("%w, the owners list holds %d nodes, while only %d nodes are available",
+			ErrInvalidOwnerSizes, numListedNodes, numNodes)
+	}
+
+	index := 0
+	return computeOwnerSizes(numNodes, func() int {
+		if index >= len(lod.sizes) {
+			return 1
+		}
+
+		index++
+		return lod.sizes[index-1]
+	}), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lod *listOwnerDistribution) IsInterfaceNil() bool {
+	return lod == nil
+}
+
+// computeOwnerSizes will call the provided handler until all the nodes are distributed. The last owner is truncated
+// to the number of remaining nodes
+func computeOwnerSizes(numNodes int, nextOwnerSize func() int) []int {
+	sizes := make([]int, 0)
+	for numNodes > 0 {
+		size := nextOwnerSize()
+		if size > numNodes {
+			size = numNodes
+		}
+
+		sizes = append(sizes, size)
+		numNodes -= size
+	}
+
+	return sizes
+}
@@ -0,0 +1,187 @@
+package generate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sumOwnerSizes(sizes []int) int {
+	sum := 0
+	for _, size := range sizes {
+		sum += size
+	}
+
+	return sum
+}
+
+func requireOwnerSizes(t *testing.T, ownerDistribution OwnerDistribution, numNodes int) []int {
+	sizes, err := ownerDistribution.ComputeOwnerSizes(numNodes)
+	require.Nil(t, err)
+
+	return sizes
+}
+
+func TestNewFixedOwnerDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero nodes on owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		fod, err := NewFixedOwnerDistribution(0)
+		assert.Nil(t, fod)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fod, err := NewFixedOwnerDistribution(3)
+		require.Nil(t, err)
+		assert.Equal(t, []int{3, 3, 3, 1}, requireOwnerSizes(t, fod, 10))
+		assert.Equal(t, 0, len(requireOwnerSizes(t, fod, 0)))
+	})
+}
+
+func TestNewUniformOwnerDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero max nodes on owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		uod, err := NewUniformOwnerDistribution(0, &mock.IntRandomizerStub{})
+		assert.Nil(t, uod)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("nil randomizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		uod, err := NewUniformOwnerDistribution(3, nil)
+		assert.Nil(t, uod)
+		assert.Equal(t, ErrNilRandomizer, err)
+	})
+	t.Run("the maximum value should be reachable", func(t *testing.T) {
+		t.Parallel()
+
+		randomizer := &mock.IntRandomizerStub{
+			IntnCalled: func(n int) int {
+				return n - 1
+			},
+		}
+		uod, err := NewUniformOwnerDistribution(4, randomizer)
+		require.Nil(t, err)
+		assert.Equal(t, []int{4, 4, 2}, requireOwnerSizes(t, uod, 10))
+	})
+	t.Run("the minimum value should be 1", func(t *testing.T) {
+		t.Parallel()
+
+		uod, err := NewUniformOwnerDistribution(4, &mock.IntRandomizerStub{})
+		require.Nil(t, err)
+		assert.Equal(t, []int{1, 1, 1}, requireOwnerSizes(t, uod, 3))
+	})
+}
+
+func TestNewZipfOwnerDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero max nodes on owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		zod, err := NewZipfOwnerDistribution(0, 1, &mock.IntRandomizerStub{})
+		assert.Nil(t, zod)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid exponent should error", func(t *testing.T) {
+		t.Parallel()
+
+		zod, err := NewZipfOwnerDistribution(10, 0, &mock.IntRandomizerStub{})
+		assert.Nil(t, zod)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("nil randomizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		zod, err := NewZipfOwnerDistribution(10, 1, nil)
+		assert.Nil(t, zod)
+		assert.Equal(t, ErrNilRandomizer, err)
+	})
+	t.Run("should map the random values on the owner sizes", func(t *testing.T) {
+		t.Parallel()
+
+		values := []int{0, 999999, 1000000, 1499999, 1500000, 1833332}
+		index := 0
+		randomizer := &mock.IntRandomizerStub{
+			IntnCalled: func(n int) int {
+				// weights: 1000000, 500000, 333333
+				assert.Equal(t, 1833333, n)
+				index++
+				return values[index-1]
+			},
+		}
+		zod, err := NewZipfOwnerDistribution(3, 1, randomizer)
+		require.Nil(t, err)
+		assert.Equal(t, []int{1, 1, 2, 2, 3, 3}, requireOwnerSizes(t, zod, 12))
+	})
+	t.Run("small owners should prevail", func(t *testing.T) {
+		t.Parallel()
+
+		counter := 0
+		randomizer := &mock.IntRandomizerStub{
+			IntnCalled: func(n int) int {
+				counter = (counter + 7919) % n
+				return counter
+			},
+		}
+		zod, err := NewZipfOwnerDistribution(20, 1.5, randomizer)
+		require.Nil(t, err)
+
+		sizes := requireOwnerSizes(t, zod, 1000)
+		assert.Equal(t, 1000, sumOwnerSizes(sizes))
+		numSoloOwners := 0
+		for _, size := range sizes {
+			assert.True(t, size >= 1 && size <= 20)
+			if size == 1 {
+				numSoloOwners++
+			}
+		}
+		assert.True(t, numSoloOwners > len(sizes)/2)
+	})
+}
+
+func TestNewListOwnerDistribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty list should error", func(t *testing.T) {
+		t.Parallel()
+
+		lod, err := NewListOwnerDistribution(nil)
+		assert.Nil(t, lod)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("zero size should error", func(t *testing.T) {
+		t.Parallel()
+
+		lod, err := NewListOwnerDistribution([]uint{2, 0})
+		assert.Nil(t, lod)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lod, err := NewListOwnerDistribution([]uint{5, 2, 1})
+		require.Nil(t, err)
+		assert.Equal(t, []int{5, 2, 1, 1, 1}, requireOwnerSizes(t, lod, 10))
+		assert.Equal(t, []int{5, 2, 1}, requireOwnerSizes(t, lod, 8))
+	})
+	t.Run("more listed nodes than available should error", func(t *testing.T) {
+		t.Parallel()
+
+		lod, err := NewListOwnerDistribution([]uint{100, 5})
+		require.Nil(t, err)
+		sizes, err := lod.ComputeOwnerSizes(36)
+		assert.Nil(t, sizes)
+		assert.True(t, errors.Is(err, ErrInvalidOwnerSizes))
+	})
+}
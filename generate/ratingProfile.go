@@ -0,0 +1,117 @@
+package generate
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type fixedRatingProfile struct {
+	rating uint32
+}
+
+// NewFixedRatingProfile will create a rating profile in which every node starts with the same rating
+func NewFixedRatingProfile(rating uint32) (*fixedRatingProfile, error) {
+	if rating == 0 {
+		return nil, fmt.Errorf("%w for the initial rating: 0", ErrInvalidValue)
+	}
+
+	return &fixedRatingProfile{
+		rating: rating,
+	}, nil
+}
+
+// ComputeRating returns the fixed rating
+func (frp *fixedRatingProfile) ComputeRating(_ []byte) uint32 {
+	return frp.rating
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (frp *fixedRatingProfile) IsInterfaceNil() bool {
+	return frp == nil
+}
+
+type randomRatingProfile struct {
+	minRating  uint32
+	maxRating  uint32
+	randomizer IntRandomizer
+}
+
+// NewRandomRatingProfile will create a rating profile in which each node starts with a random rating, uniformly
+// chosen in the [minRating, maxRating] interval
+func NewRandomRatingProfile(minRating uint32, maxRating uint32, randomizer IntRandomizer) (*randomRatingProfile, error) {
+	if minRating == 0 {
+		return nil, fmt.Errorf("%w for the minimum initial rating: 0", ErrInvalidValue)
+	}
+	if maxRating < minRating {
+		return nil, fmt.Errorf("%w, the maximum initial rating %d is lower than the minimum initial rating %d",
+			ErrInvalidValue, maxRating, minRating)
+	}
+	if check.IfNil(randomizer) {
+		return nil, ErrNilRandomizer
+	}
+
+	return &randomRatingProfile{
+		minRating:  minRating,
+		maxRating:  maxRating,
+		randomizer: randomizer,
+	}, nil
+}
+
+// ComputeRating returns a random rating in the [minRating, maxRating] interval
+func (rrp *randomRatingProfile) ComputeRating(_ []byte) uint32 {
+	numValues := int(rrp.maxRating-rrp.minRating) + 1
+
+	return rrp.minRating + uint32(rrp.randomizer.Intn(numValues))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rrp *randomRatingProfile) IsInterfaceNil() bool {
+	return rrp == nil
+}
+
+type ownerOverridesRatingProfile struct {
+	defaultProfile RatingProfile
+	overrides      map[string]uint32
+}
+
+// NewOwnerOverridesRatingProfile will create a rating profile in which the nodes held by the provided owners start
+// with the overridden ratings, the rest of the nodes using the default profile. The overrides map is keyed by the
+// owner public key bytes
+func NewOwnerOverridesRatingProfile(
+	defaultProfile RatingProfile,
+	overrides map[string]uint32,
+) (*ownerOverridesRatingProfile, error) {
+	if check.IfNil(defaultProfile) {
+		return nil, ErrNilRatingProfile
+	}
+
+	overridesCopy := make(map[string]uint32, len(overrides))
+	for owner, rating := range overrides {
+		if rating == 0 {
+			return nil, fmt.Errorf("%w for the initial rating override: 0", ErrInvalidValue)
+		}
+
+		overridesCopy[owner] = rating
+	}
+
+	return &ownerOverridesRatingProfile{
+		defaultProfile: defaultProfile,
+		overrides:      overridesCopy,
+	}, nil
+}
+
+// ComputeRating returns the overridden rating of the owner, if any, otherwise the rating computed by the default profile
+func (oorp *ownerOverridesRatingProfile) ComputeRating(ownerPubKeyBytes []byte) uint32 {
+	rating, found := oorp.overrides[string(ownerPubKeyBytes)]
+	if found {
+		return rating
+	}
+
+	return oorp.defaultProfile.ComputeRating(ownerPubKeyBytes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oorp *ownerOverridesRatingProfile) IsInterfaceNil() bool {
+	return oorp == nil
+}
@@ -0,0 +1,121 @@
+package generate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestRatingProfile(rating uint32) RatingProfile {
+	profile, _ := NewFixedRatingProfile(rating)
+
+	return profile
+}
+
+func TestNewFixedRatingProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero rating should error", func(t *testing.T) {
+		t.Parallel()
+
+		frp, err := NewFixedRatingProfile(0)
+		assert.Nil(t, frp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		frp, err := NewFixedRatingProfile(5000001)
+		require.Nil(t, err)
+		assert.False(t, frp.IsInterfaceNil())
+		assert.Equal(t, uint32(5000001), frp.ComputeRating([]byte("owner1")))
+		assert.Equal(t, uint32(5000001), frp.ComputeRating([]byte("owner2")))
+	})
+}
+
+func TestNewRandomRatingProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero minimum rating should error", func(t *testing.T) {
+		t.Parallel()
+
+		rrp, err := NewRandomRatingProfile(0, 10, &mock.IntRandomizerStub{})
+		assert.Nil(t, rrp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("maximum lower than minimum should error", func(t *testing.T) {
+		t.Parallel()
+
+		rrp, err := NewRandomRatingProfile(10, 9, &mock.IntRandomizerStub{})
+		assert.Nil(t, rrp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("nil randomizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rrp, err := NewRandomRatingProfile(10, 20, nil)
+		assert.Nil(t, rrp)
+		assert.Equal(t, ErrNilRandomizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rrp, err := NewRandomRatingProfile(10, 20, createSequenceRandomizer())
+		require.Nil(t, err)
+		assert.False(t, rrp.IsInterfaceNil())
+
+		for i := 0; i < 100; i++ {
+			rating := rrp.ComputeRating(nil)
+			assert.True(t, rating >= 10 && rating <= 20)
+		}
+	})
+	t.Run("extreme values should work", func(t *testing.T) {
+		t.Parallel()
+
+		rrp, _ := NewRandomRatingProfile(10, 20, &mock.IntRandomizerStub{
+			IntnCalled: func(n int) int {
+				assert.Equal(t, 11, n)
+				return n - 1
+			},
+		})
+		assert.Equal(t, uint32(20), rrp.ComputeRating(nil))
+
+		rrp, _ = NewRandomRatingProfile(10, 10, &mock.IntRandomizerStub{})
+		assert.Equal(t, uint32(10), rrp.ComputeRating(nil))
+	})
+}
+
+func TestNewOwnerOverridesRatingProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil default profile should error", func(t *testing.T) {
+		t.Parallel()
+
+		oorp, err := NewOwnerOverridesRatingProfile(nil, nil)
+		assert.Nil(t, oorp)
+		assert.Equal(t, ErrNilRatingProfile, err)
+	})
+	t.Run("zero override should error", func(t *testing.T) {
+		t.Parallel()
+
+		oorp, err := NewOwnerOverridesRatingProfile(createTestRatingProfile(50), map[string]uint32{"owner1": 0})
+		assert.Nil(t, oorp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		overrides := map[string]uint32{"owner1": 10}
+		oorp, err := NewOwnerOverridesRatingProfile(createTestRatingProfile(50), overrides)
+		require.Nil(t, err)
+		assert.False(t, oorp.IsInterfaceNil())
+
+		overrides["owner2"] = 20
+		assert.Equal(t, uint32(10), oorp.ComputeRating([]byte("owner1")))
+		assert.Equal(t, uint32(50), oorp.ComputeRating([]byte("owner2")))
+		assert.Equal(t, uint32(50), oorp.ComputeRating(nil))
+	})
+}
@@ -0,0 +1,127 @@
+package generate
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// topUpRandomSteps is the number of distinct values the random top-up policy can choose from, in its interval
+const topUpRandomSteps = 1000000
+
+type noneTopUpPolicy struct {
+}
+
+// NewNoneTopUpPolicy will create a top-up policy in which the owners stake exactly the price of their nodes
+func NewNoneTopUpPolicy() *noneTopUpPolicy {
+	return &noneTopUpPolicy{}
+}
+
+// ComputeTopUp returns 0
+func (ntp *noneTopUpPolicy) ComputeTopUp(_ *big.Int) *big.Int {
+	return big.NewInt(0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ntp *noneTopUpPolicy) IsInterfaceNil() bool {
+	return ntp == nil
+}
+
+type fixedTopUpPolicy struct {
+	topUp *big.Int
+}
+
+// NewFixedTopUpPolicy will create a top-up policy in which every owner stakes the same value on top of its nodes price
+func NewFixedTopUpPolicy(topUp *big.Int) (*fixedTopUpPolicy, error) {
+	if topUp == nil {
+		return nil, ErrNilTopUpValue
+	}
+	if topUp.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w for the top-up value", ErrInvalidValue)
+	}
+
+	return &fixedTopUpPolicy{
+		topUp: big.NewInt(0).Set(topUp),
+	}, nil
+}
+
+// ComputeTopUp returns the fixed top-up value
+func (ftp *fixedTopUpPolicy) ComputeTopUp(_ *big.Int) *big.Int {
+	return big.NewInt(0).Set(ftp.topUp)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ftp *fixedTopUpPolicy) IsInterfaceNil() bool {
+	return ftp == nil
+}
+
+type randomTopUpPolicy struct {
+	minTopUp   *big.Int
+	maxTopUp   *big.Int
+	randomizer IntRandomizer
+}
+
+// NewRandomTopUpPolicy will create a top-up policy in which each owner stakes, on top of its nodes price, a random
+// value uniformly chosen in the [minTopUp, maxTopUp] interval
+func NewRandomTopUpPolicy(minTopUp *big.Int, maxTopUp *big.Int, randomizer IntRandomizer) (*randomTopUpPolicy, error) {
+	if minTopUp == nil || maxTopUp == nil {
+		return nil, ErrNilTopUpValue
+	}
+	if minTopUp.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w for the minimum top-up value", ErrInvalidValue)
+	}
+	if maxTopUp.Cmp(minTopUp) < 0 {
+		return nil, fmt.Errorf("%w, the maximum top-up value %s is lower than the minimum top-up value %s",
+			ErrInvalidValue, maxTopUp.String(), minTopUp.String())
+	}
+	if check.IfNil(randomizer) {
+		return nil, ErrNilRandomizer
+	}
+
+	return &randomTopUpPolicy{
+		minTopUp:   big.NewInt(0).Set(minTopUp),
+		maxTopUp:   big.NewInt(0).Set(maxTopUp),
+		randomizer: randomizer,
+	}, nil
+}
+
+// ComputeTopUp returns a random top-up value in the [minTopUp, maxTopUp] interval
+func (rtp *randomTopUpPolicy) ComputeTopUp(_ *big.Int) *big.Int {
+	step := big.NewInt(int64(rtp.randomizer.Intn(topUpRandomSteps + 1)))
+
+	topUp := big.NewInt(0).Sub(rtp.maxTopUp, rtp.minTopUp)
+	topUp.Mul(topUp, step)
+	topUp.Div(topUp, big.NewInt(topUpRandomSteps))
+
+	return topUp.Add(topUp, rtp.minTopUp)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtp *randomTopUpPolicy) IsInterfaceNil() bool {
+	return rtp == nil
+}
+
+type proportionalTopUpPolicy struct {
+	percent *big.Int
+}
+
+// NewProportionalTopUpPolicy will create a top-up policy in which each owner stakes, on top of its nodes price, the
+// provided percent of its nodes price
+func NewProportionalTopUpPolicy(percent uint64) *proportionalTopUpPolicy {
+	return &proportionalTopUpPolicy{
+		percent: big.NewInt(0).SetUint64(percent),
+	}
+}
+
+// ComputeTopUp returns the configured percent of the provided nodes price
+func (ptp *proportionalTopUpPolicy) ComputeTopUp(nodesPrice *big.Int) *big.Int {
+	topUp := big.NewInt(0).Mul(nodesPrice, ptp.percent)
+
+	return topUp.Div(topUp, big.NewInt(100))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ptp *proportionalTopUpPolicy) IsInterfaceNil() bool {
+	return ptp == nil
+}
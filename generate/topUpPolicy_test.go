@@ -0,0 +1,105 @@
+package generate
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoneTopUpPolicy_ComputeTopUp(t *testing.T) {
+	t.Parallel()
+
+	ntp := NewNoneTopUpPolicy()
+	assert.False(t, ntp.IsInterfaceNil())
+	assert.Equal(t, big.NewInt(0), ntp.ComputeTopUp(big.NewInt(2500)))
+}
+
+func TestNewFixedTopUpPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil top-up should error", func(t *testing.T) {
+		t.Parallel()
+
+		ftp, err := NewFixedTopUpPolicy(nil)
+		assert.Nil(t, ftp)
+		assert.Equal(t, ErrNilTopUpValue, err)
+	})
+	t.Run("negative top-up should error", func(t *testing.T) {
+		t.Parallel()
+
+		ftp, err := NewFixedTopUpPolicy(big.NewInt(-1))
+		assert.Nil(t, ftp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ftp, err := NewFixedTopUpPolicy(big.NewInt(300))
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(300), ftp.ComputeTopUp(big.NewInt(2500)))
+		assert.Equal(t, big.NewInt(300), ftp.ComputeTopUp(big.NewInt(7500)))
+	})
+}
+
+func TestNewRandomTopUpPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil values should error", func(t *testing.T) {
+		t.Parallel()
+
+		rtp, err := NewRandomTopUpPolicy(nil, big.NewInt(1), &mock.IntRandomizerStub{})
+		assert.Nil(t, rtp)
+		assert.Equal(t, ErrNilTopUpValue, err)
+
+		rtp, err = NewRandomTopUpPolicy(big.NewInt(1), nil, &mock.IntRandomizerStub{})
+		assert.Nil(t, rtp)
+		assert.Equal(t, ErrNilTopUpValue, err)
+	})
+	t.Run("maximum lower than minimum should error", func(t *testing.T) {
+		t.Parallel()
+
+		rtp, err := NewRandomTopUpPolicy(big.NewInt(10), big.NewInt(9), &mock.IntRandomizerStub{})
+		assert.Nil(t, rtp)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("nil randomizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rtp, err := NewRandomTopUpPolicy(big.NewInt(1), big.NewInt(10), nil)
+		assert.Nil(t, rtp)
+		assert.Equal(t, ErrNilRandomizer, err)
+	})
+}
+
+func TestRandomTopUpPolicy_ComputeTopUp(t *testing.T) {
+	t.Parallel()
+
+	step := 0
+	randomizer := &mock.IntRandomizerStub{
+		IntnCalled: func(n int) int {
+			return step
+		},
+	}
+
+	rtp, err := NewRandomTopUpPolicy(big.NewInt(1000), big.NewInt(3000), randomizer)
+	require.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(1000), rtp.ComputeTopUp(big.NewInt(2500)))
+	step = topUpRandomSteps / 2
+	assert.Equal(t, big.NewInt(2000), rtp.ComputeTopUp(big.NewInt(2500)))
+	step = topUpRandomSteps
+	assert.Equal(t, big.NewInt(3000), rtp.ComputeTopUp(big.NewInt(2500)))
+}
+
+func TestProportionalTopUpPolicy_ComputeTopUp(t *testing.T) {
+	t.Parallel()
+
+	ptp := NewProportionalTopUpPolicy(20)
+	assert.Equal(t, big.NewInt(500), ptp.ComputeTopUp(big.NewInt(2500)))
+	assert.Equal(t, big.NewInt(1500), ptp.ComputeTopUp(big.NewInt(7500)))
+	assert.Equal(t, big.NewInt(0), NewProportionalTopUpPolicy(0).ComputeTopUp(big.NewInt(7500)))
+}
@@ -10,54 +10,53 @@ import (
 )
 
 type walletKeyGenerator struct {
-	keyGen     crypto.KeyGenerator
-	randomizer IntRandomizer
-	nodePrice  *big.Int
+	keyGen    crypto.KeyGenerator
+	nodePrice *big.Int
 }
 
 // NewWalletKeyGenerator will create a new instance for the wallet key generator
-func NewWalletKeyGenerator(keyGen crypto.KeyGenerator, randomizer IntRandomizer, nodePrice *big.Int) (*walletKeyGenerator, error) {
+func NewWalletKeyGenerator(keyGen crypto.KeyGenerator, nodePrice *big.Int) (*walletKeyGenerator, error) {
 	if check.IfNil(keyGen) {
 		return nil, ErrNilKeyGenerator
 	}
-	if check.IfNil(randomizer) {
-		return nil, ErrNilRandomizer
-	}
 	if nodePrice == nil {
 		return nil, ErrNilNodePrice
 	}
 
 	return &walletKeyGenerator{
-		keyGen:     keyGen,
-		randomizer: randomizer,
-		nodePrice:  nodePrice,
+		keyGen:    keyGen,
+		nodePrice: nodePrice,
 	}, nil
 }
 
-// GenerateKeys will generate the number of keys provided
-func (wkg *walletKeyGenerator) GenerateKeys(blsKeys []*data.BlsKey, maxNumKeysOnOwner int) ([]*data.WalletKey, error) {
-	if maxNumKeysOnOwner < 1 {
-		return nil, fmt.Errorf("%w for maxNumKeysOnOwner", ErrInvalidValue)
+// GenerateKeys will generate the owners of the provided BLS keys, the number of keys held by each owner being
+// given by the owner distribution. Each owner stakes the price of its nodes plus the top-up given by the top-up policy
+func (wkg *walletKeyGenerator) GenerateKeys(
+	blsKeys []*data.BlsKey,
+	ownerDistribution OwnerDistribution,
+	topUpPolicy TopUpPolicy,
+) ([]*data.WalletKey, error) {
+	if check.IfNil(ownerDistribution) {
+		return nil, ErrNilOwnerDistribution
+	}
+	if check.IfNil(topUpPolicy) {
+		return nil, ErrNilTopUpPolicy
+	}
+
+	ownerSizes, err := ownerDistribution.ComputeOwnerSizes(len(blsKeys))
+	if err != nil {
+		return nil, err
+	}
+	err = checkOwnerSizes(ownerSizes, len(blsKeys))
+	if err != nil {
+		return nil, err
 	}
 
-	keys := make([]*data.WalletKey, 0)
+	keys := make([]*data.WalletKey, 0, len(ownerSizes))
 	blsKeysPool := make([]*data.BlsKey, len(blsKeys))
 	copy(blsKeysPool, blsKeys)
 
-	for {
-		if len(blsKeysPool) == 0 {
-			break
-		}
-
-		numKeysOnOwner := maxNumKeysOnOwner
-		if maxNumKeysOnOwner > 1 {
-			// create a random number
-			numKeysOnOwner = wkg.randomizer.Intn(maxNumKeysOnOwner-1) + 1
-			if numKeysOnOwner > len(blsKeysPool) {
-				numKeysOnOwner = len(blsKeysPool)
-			}
-		}
-
+	for _, numKeysOnOwner := range ownerSizes {
 		extractedBlsKeys := blsKeysPool[:numKeysOnOwner]
 		blsKeysPool = blsKeysPool[numKeysOnOwner:]
 
@@ -66,7 +65,8 @@ func (wkg *walletKeyGenerator) GenerateKeys(blsKeys []*data.BlsKey, maxNumKeysOn
 			return nil, err
 		}
 		walletKey.BlsKeys = extractedBlsKeys
-		walletKey.StakedValue = big.NewInt(0).Mul(wkg.nodePrice, big.NewInt(int64(len(extractedBlsKeys))))
+		nodesPrice := big.NewInt(0).Mul(wkg.nodePrice, big.NewInt(int64(len(extractedBlsKeys))))
+		walletKey.StakedValue = nodesPrice.Add(nodesPrice, topUpPolicy.ComputeTopUp(nodesPrice))
 
 		keys = append(keys, walletKey)
 	}
@@ -74,6 +74,25 @@ func (wkg *walletKeyGenerator) GenerateKeys(blsKeys []*data.BlsKey, maxNumKeysOn
 	return keys, nil
 }
 
+// checkOwnerSizes checks that every owner holds at least a node and that all the nodes are held, as the owner
+// distribution can be provided by other packages
+func checkOwnerSizes(ownerSizes []int, numNodes int) error {
+	numDistributedNodes := 0
+	for i, size := range ownerSizes {
+		if size <= 0 {
+			return fmt.Errorf("%w, the owner at index %d holds %d nodes", ErrInvalidOwnerSizes, i, size)
+		}
+
+		numDistributedNodes += size
+	}
+	if numDistributedNodes != numNodes {
+		return fmt.Errorf("%w, the owners hold %d nodes, number of nodes: %d",
+			ErrInvalidOwnerSizes, numDistributedNodes, numNodes)
+	}
+
+	return nil
+}
+
 func (wkg *walletKeyGenerator) generateWalletKey() (*data.WalletKey, error) {
 	var err error
 	sk, pk := wkg.keyGen.GeneratePair()
@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -35,10 +36,12 @@ func TestWalletKeyGenerator_GenerateKeysShouldWork(t *testing.T) {
 	}
 
 	nodePrice := big.NewInt(2500)
-	vkg, err := NewWalletKeyGenerator(keygen, intRandomizer, nodePrice)
+	vkg, err := NewWalletKeyGenerator(keygen, nodePrice)
 	require.Nil(t, err)
 
-	keys, err := vkg.GenerateKeys(blsKeys, 2)
+	ownerDistribution, _ := NewUniformOwnerDistribution(9, intRandomizer)
+	keys, err := vkg.GenerateKeys(blsKeys, ownerDistribution, NewNoneTopUpPolicy())
+	assert.Nil(t, err)
 	assert.Equal(t, 9, len(keys))
 
 	for i, key := range keys {
@@ -62,10 +65,12 @@ func TestWalletKeyGenerator_GenerateKeysWithOneBlsKeyShouldWork(t *testing.T) {
 	}
 
 	nodePrice := big.NewInt(2500)
-	vkg, err := NewWalletKeyGenerator(keygen, &mock.IntRandomizerStub{}, nodePrice)
+	vkg, err := NewWalletKeyGenerator(keygen, nodePrice)
 	require.Nil(t, err)
 
-	keys, err := vkg.GenerateKeys(blsKeys, 1)
+	ownerDistribution, _ := NewFixedOwnerDistribution(1)
+	keys, err := vkg.GenerateKeys(blsKeys, ownerDistribution, NewNoneTopUpPolicy())
+	assert.Nil(t, err)
 	assert.Equal(t, numBlsKeys, len(keys))
 
 	for _, key := range keys {
@@ -74,6 +79,77 @@ func TestWalletKeyGenerator_GenerateKeysWithOneBlsKeyShouldWork(t *testing.T) {
 	}
 }
 
+func TestWalletKeyGenerator_GenerateKeysWithTopUpShouldWork(t *testing.T) {
+	t.Parallel()
+
+	suite := ed25519.NewEd25519()
+	keygen := signing.NewKeyGenerator(suite)
+
+	numBlsKeys := 10
+	blsKeys := make([]*data.BlsKey, 0)
+	for i := 0; i < numBlsKeys; i++ {
+		blsKeys = append(blsKeys, &data.BlsKey{
+			PubKeyBytes: []byte("pubkey"),
+		})
+	}
+
+	nodePrice := big.NewInt(2500)
+	vkg, err := NewWalletKeyGenerator(keygen, nodePrice)
+	require.Nil(t, err)
+
+	ownerDistribution, _ := NewFixedOwnerDistribution(2)
+	topUpPolicy, _ := NewFixedTopUpPolicy(big.NewInt(300))
+	keys, err := vkg.GenerateKeys(blsKeys, ownerDistribution, topUpPolicy)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(keys))
+
+	for _, key := range keys {
+		assert.Equal(t, 2, len(key.BlsKeys))
+		assert.Equal(t, big.NewInt(5300), key.StakedValue)
+	}
+}
+
+func TestWalletKeyGenerator_GenerateKeysNilTopUpPolicyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	suite := ed25519.NewEd25519()
+	vkg, _ := NewWalletKeyGenerator(signing.NewKeyGenerator(suite), big.NewInt(2500))
+
+	ownerDistribution, _ := NewFixedOwnerDistribution(1)
+	keys, err := vkg.GenerateKeys(make([]*data.BlsKey, 1), ownerDistribution, nil)
+	assert.Nil(t, keys)
+	assert.Equal(t, ErrNilTopUpPolicy, err)
+}
+
+func TestWalletKeyGenerator_GenerateKeysInvalidOwnerSizesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	suite := ed25519.NewEd25519()
+	vkg, _ := NewWalletKeyGenerator(signing.NewKeyGenerator(suite), big.NewInt(2500))
+
+	testCases := map[string][]int{
+		"zero size":          {2, 0, 2},
+		"negative size":      {5, -1},
+		"too many nodes":     {3, 3},
+		"nodes not all held": {2, 1},
+	}
+	for name, ownerSizes := range testCases {
+		sizes := ownerSizes
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ownerDistribution := &mock.OwnerDistributionStub{
+				ComputeOwnerSizesCalled: func(numNodes int) ([]int, error) {
+					return sizes, nil
+				},
+			}
+			keys, err := vkg.GenerateKeys(make([]*data.BlsKey, 4), ownerDistribution, NewNoneTopUpPolicy())
+			assert.Nil(t, keys)
+			assert.True(t, errors.Is(err, ErrInvalidOwnerSizes))
+		})
+	}
+}
+
 func TestWalletKeyGenerator_GenerateAdditionalKeysShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -81,7 +157,7 @@ func TestWalletKeyGenerator_GenerateAdditionalKeysShouldWork(t *testing.T) {
 	keygen := signing.NewKeyGenerator(suite)
 
 	nodePrice := big.NewInt(2500)
-	vkg, err := NewWalletKeyGenerator(keygen, &mock.IntRandomizerStub{}, nodePrice)
+	vkg, err := NewWalletKeyGenerator(keygen, nodePrice)
 	require.Nil(t, err)
 
 	numKeys := 100
@@ -0,0 +1,30 @@
+package mock
+
+import "github.com/multiversx/mx-chain-deploy-go/data"
+
+// OutputWriterStub -
+type OutputWriterStub struct {
+	WriteDataCalled func(generatedOutput data.GeneratorOutput) error
+	CloseCalled     func()
+}
+
+// WriteData -
+func (ows *OutputWriterStub) WriteData(generatedOutput data.GeneratorOutput) error {
+	if ows.WriteDataCalled != nil {
+		return ows.WriteDataCalled(generatedOutput)
+	}
+
+	return nil
+}
+
+// Close -
+func (ows *OutputWriterStub) Close() {
+	if ows.CloseCalled != nil {
+		ows.CloseCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (ows *OutputWriterStub) IsInterfaceNil() bool {
+	return ows == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// OwnerDistributionStub -
+type OwnerDistributionStub struct {
+	ComputeOwnerSizesCalled func(numNodes int) ([]int, error)
+}
+
+// ComputeOwnerSizes -
+func (ods *OwnerDistributionStub) ComputeOwnerSizes(numNodes int) ([]int, error) {
+	if ods.ComputeOwnerSizesCalled != nil {
+		return ods.ComputeOwnerSizesCalled(numNodes)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (ods *OwnerDistributionStub) IsInterfaceNil() bool {
+	return ods == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// RatingProfileStub -
+type RatingProfileStub struct {
+	ComputeRatingCalled func(ownerPubKeyBytes []byte) uint32
+}
+
+// ComputeRating -
+func (rps *RatingProfileStub) ComputeRating(ownerPubKeyBytes []byte) uint32 {
+	if rps.ComputeRatingCalled != nil {
+		return rps.ComputeRatingCalled(ownerPubKeyBytes)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (rps *RatingProfileStub) IsInterfaceNil() bool {
+	return rps == nil
+}
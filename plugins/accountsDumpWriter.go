@@ -0,0 +1,123 @@
+package plugins
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	deployCore "github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+const defaultAccountsDumpFileName = "accounts.jsonl"
+const externalAccountKind = "external"
+
+// AccountDump is one line of the accounts dump, describing a genesis account. The accounts that were not generated,
+// such as the allocations, the snapshot accounts or the treasury, are of the external kind
+type AccountDump struct {
+	Address           string `json:"address"`
+	Kind              string `json:"kind"`
+	Supply            string `json:"supply"`
+	Balance           string `json:"balance"`
+	StakingValue      string `json:"stakingValue"`
+	DelegationAddress string `json:"delegationAddress,omitempty"`
+	DelegationValue   string `json:"delegationValue,omitempty"`
+	NumNodes          int    `json:"numNodes"`
+}
+
+// ArgAccountsDumpWriter represents the accounts dump writer constructor argument
+type ArgAccountsDumpWriter struct {
+	OutputDirectory       string
+	FileName              string
+	WalletPubKeyConverter core.PubkeyConverter
+}
+
+type accountsDumpWriter struct {
+	accountsHandler       FileHandler
+	walletPubKeyConverter core.PubkeyConverter
+}
+
+// NewAccountsDumpWriter will create a new writer dumping every genesis account as a JSON line
+func NewAccountsDumpWriter(arg ArgAccountsDumpWriter) (*accountsDumpWriter, error) {
+	if len(arg.OutputDirectory) == 0 {
+		return nil, ErrEmptyOutputDirectory
+	}
+	if check.IfNil(arg.WalletPubKeyConverter) {
+		return nil, fmt.Errorf("%w for WalletPubKeyConverter", ErrNilPubKeyConverter)
+	}
+
+	accountsHandler, err := deployCore.NewFileHandler(arg.OutputDirectory, arg.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsDumpWriter{
+		accountsHandler:       accountsHandler,
+		walletPubKeyConverter: arg.WalletPubKeyConverter,
+	}, nil
+}
+
+// WriteData will write one line for each initial account of the generated output
+func (adw *accountsDumpWriter) WriteData(generatedOutput data.GeneratorOutput) error {
+	accountKinds := make(map[string]string)
+	addKinds := func(keys []*data.WalletKey, kind string) {
+		for _, key := range keys {
+			address, _ := adw.walletPubKeyConverter.Encode(key.PubKeyBytes)
+			accountKinds[address] = kind
+		}
+	}
+	addKinds(generatedOutput.WalletKeys, ownerAccountKind)
+	addKinds(generatedOutput.DelegatorKeys, delegatorAccountKind)
+	addKinds(generatedOutput.AdditionalKeys, additionalAccountKind)
+
+	numNodes := make(map[string]int)
+	for _, initialNode := range generatedOutput.InitialNodes {
+		numNodes[initialNode.Address]++
+	}
+
+	for _, account := range generatedOutput.InitialAccounts {
+		kind, found := accountKinds[account.Address]
+		if !found {
+			kind = externalAccountKind
+		}
+
+		accountDump := &AccountDump{
+			Address:      account.Address,
+			Kind:         kind,
+			Supply:       amountString(account.Supply),
+			Balance:      amountString(account.Balance),
+			StakingValue: amountString(account.StakingValue),
+			NumNodes:     numNodes[account.Address],
+		}
+		if account.Delegation != nil && len(account.Delegation.Address) > 0 {
+			accountDump.DelegationAddress = account.Delegation.Address
+			accountDump.DelegationValue = amountString(account.Delegation.Value)
+		}
+
+		err := adw.accountsHandler.WriteObjectLineInFile(accountDump)
+		if err != nil {
+			return fmt.Errorf("%w for address %s", err, account.Address)
+		}
+	}
+
+	return nil
+}
+
+func amountString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// Close closes the accounts dump file
+func (adw *accountsDumpWriter) Close() {
+	adw.accountsHandler.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adw *accountsDumpWriter) IsInterfaceNil() bool {
+	return adw == nil
+}
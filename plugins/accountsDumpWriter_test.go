@@ -0,0 +1,192 @@
+package plugins
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	genesisData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestWalletPubKeyConverter(t *testing.T) core.PubkeyConverter {
+	converter, err := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	require.Nil(t, err)
+
+	return converter
+}
+
+func createTestPubKeyBytes(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, 32)
+}
+
+func encodeTestAddress(t *testing.T, converter core.PubkeyConverter, fill byte) string {
+	address, err := converter.Encode(createTestPubKeyBytes(fill))
+	require.Nil(t, err)
+
+	return address
+}
+
+func readAccountDumps(t *testing.T, filePath string) []AccountDump {
+	buff, err := os.ReadFile(filePath)
+	require.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(string(buff), "\n"), "\n")
+	accountDumps := make([]AccountDump, 0, len(lines))
+	for _, line := range lines {
+		accountDump := AccountDump{}
+		err = json.Unmarshal([]byte(line), &accountDump)
+		require.Nil(t, err)
+
+		accountDumps = append(accountDumps, accountDump)
+	}
+
+	return accountDumps
+}
+
+func TestNewAccountsDumpWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty output directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		adw, err := NewAccountsDumpWriter(ArgAccountsDumpWriter{
+			FileName:              defaultAccountsDumpFileName,
+			WalletPubKeyConverter: createTestWalletPubKeyConverter(t),
+		})
+		assert.Nil(t, adw)
+		assert.Equal(t, ErrEmptyOutputDirectory, err)
+	})
+	t.Run("nil wallet pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		adw, err := NewAccountsDumpWriter(ArgAccountsDumpWriter{
+			OutputDirectory: t.TempDir(),
+			FileName:        defaultAccountsDumpFileName,
+		})
+		assert.Nil(t, adw)
+		assert.True(t, errors.Is(err, ErrNilPubKeyConverter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		outputDirectory := t.TempDir()
+		adw, err := NewAccountsDumpWriter(ArgAccountsDumpWriter{
+			OutputDirectory:       outputDirectory,
+			FileName:              defaultAccountsDumpFileName,
+			WalletPubKeyConverter: createTestWalletPubKeyConverter(t),
+		})
+		require.Nil(t, err)
+		assert.False(t, adw.IsInterfaceNil())
+		adw.Close()
+
+		assert.FileExists(t, filepath.Join(outputDirectory, defaultAccountsDumpFileName))
+	})
+}
+
+func TestAccountsDumpWriter_WriteDataShouldWriteOneLinePerAccount(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestWalletPubKeyConverter(t)
+	ownerAddress := encodeTestAddress(t, converter, 1)
+	delegatorAddress := encodeTestAddress(t, converter, 2)
+	additionalAddress := encodeTestAddress(t, converter, 3)
+	externalAddress := encodeTestAddress(t, converter, 4)
+	delegationAddress := encodeTestAddress(t, converter, 5)
+
+	generatedOutput := data.GeneratorOutput{
+		WalletKeys:     []*data.WalletKey{{PubKeyBytes: createTestPubKeyBytes(1)}},
+		DelegatorKeys:  []*data.WalletKey{{PubKeyBytes: createTestPubKeyBytes(2)}},
+		AdditionalKeys: []*data.WalletKey{{PubKeyBytes: createTestPubKeyBytes(3)}},
+		InitialAccounts: []genesisData.InitialAccount{
+			{
+				Address:      ownerAddress,
+				Supply:       big.NewInt(5000),
+				Balance:      big.NewInt(1000),
+				StakingValue: big.NewInt(4000),
+			},
+			{
+				Address: delegatorAddress,
+				Supply:  big.NewInt(300),
+				Balance: big.NewInt(100),
+				Delegation: &genesisData.DelegationData{
+					Address: delegationAddress,
+					Value:   big.NewInt(200),
+				},
+			},
+			{
+				Address: additionalAddress,
+				Supply:  big.NewInt(10),
+				Balance: big.NewInt(10),
+			},
+			{
+				Address: externalAddress,
+				Supply:  big.NewInt(7),
+				Balance: big.NewInt(7),
+			},
+		},
+		InitialNodes: []*sharding.InitialNode{
+			{PubKey: "bls1", Address: ownerAddress},
+			{PubKey: "bls2", Address: ownerAddress},
+			{PubKey: "bls3", Address: delegationAddress},
+		},
+	}
+
+	outputDirectory := t.TempDir()
+	adw, err := NewAccountsDumpWriter(ArgAccountsDumpWriter{
+		OutputDirectory:       outputDirectory,
+		FileName:              defaultAccountsDumpFileName,
+		WalletPubKeyConverter: converter,
+	})
+	require.Nil(t, err)
+
+	err = adw.WriteData(generatedOutput)
+	require.Nil(t, err)
+	adw.Close()
+
+	expectedAccountDumps := []AccountDump{
+		{
+			Address:      ownerAddress,
+			Kind:         ownerAccountKind,
+			Supply:       "5000",
+			Balance:      "1000",
+			StakingValue: "4000",
+			NumNodes:     2,
+		},
+		{
+			Address:           delegatorAddress,
+			Kind:              delegatorAccountKind,
+			Supply:            "300",
+			Balance:           "100",
+			StakingValue:      "0",
+			DelegationAddress: delegationAddress,
+			DelegationValue:   "200",
+		},
+		{
+			Address:      additionalAddress,
+			Kind:         additionalAccountKind,
+			Supply:       "10",
+			Balance:      "10",
+			StakingValue: "0",
+		},
+		{
+			Address:      externalAddress,
+			Kind:         externalAccountKind,
+			Supply:       "7",
+			Balance:      "7",
+			StakingValue: "0",
+		},
+	}
+	accountDumps := readAccountDumps(t, filepath.Join(outputDirectory, defaultAccountsDumpFileName))
+	assert.Equal(t, expectedAccountDumps, accountDumps)
+}
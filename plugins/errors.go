@@ -10,3 +10,51 @@ var ErrNilPubKeyConverter = errors.New("nil pub key converter")
 
 // ErrNilShardCoordinator signals that a nil shard coordinator was provided
 var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrUnknownWalletKeyFormat signals that an unknown wallet key format was provided
+var ErrUnknownWalletKeyFormat = errors.New("unknown wallet key format")
+
+// ErrUnknownOutputLayout signals that an unknown output layout was provided
+var ErrUnknownOutputLayout = errors.New("unknown output layout")
+
+// ErrInvalidDelegationServiceFee signals that an invalid delegation service fee was provided
+var ErrInvalidDelegationServiceFee = errors.New("invalid delegation service fee")
+
+// ErrInvalidDelegationCap signals that an invalid delegation cap was provided
+var ErrInvalidDelegationCap = errors.New("invalid delegation cap")
+
+// ErrEmptyOutputDirectory signals that an empty output directory was provided
+var ErrEmptyOutputDirectory = errors.New("empty output directory")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrEncryptedKeystoreFiles signals that the keys are stored in encrypted keystore files
+var ErrEncryptedKeystoreFiles = errors.New("the keys are stored in encrypted keystore files")
+
+// ErrMissingGenesisAccount signals that a loaded wallet key does not have a genesis account
+var ErrMissingGenesisAccount = errors.New("missing genesis account")
+
+// ErrUnknownOutputWriter signals that an unknown output writer was provided
+var ErrUnknownOutputWriter = errors.New("unknown output writer")
+
+// ErrInvalidOutputWriterParameters signals that the output writer parameters are not valid
+var ErrInvalidOutputWriterParameters = errors.New("invalid output writer parameters")
+
+// ErrInvalidOutputWriterName signals that an output writer was registered with an empty or malformed name
+var ErrInvalidOutputWriterName = errors.New("invalid output writer name")
+
+// ErrNilOutputWriterConstructor signals that an output writer was registered without a constructor
+var ErrNilOutputWriterConstructor = errors.New("nil output writer constructor")
+
+// ErrOutputWriterAlreadyRegistered signals that an output writer was already registered under the same name
+var ErrOutputWriterAlreadyRegistered = errors.New("output writer already registered")
+
+// ErrDuplicatedOutputWriter signals that the same output writer was enabled more than once
+var ErrDuplicatedOutputWriter = errors.New("duplicated output writer")
+
+// ErrNoOutputWriter signals that no output writer was enabled
+var ErrNoOutputWriter = errors.New("no output writer")
+
+// ErrInvalidNumOfShards signals that an invalid number of shards was provided
+var ErrInvalidNumOfShards = errors.New("invalid number of shards")
@@ -2,6 +2,8 @@ package plugins
 
 import (
 	"fmt"
+	"io"
+	"path/filepath"
 
 	mxCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-deploy-go/core"
@@ -14,8 +16,15 @@ const genesisFilename = "genesis.json"
 const nodesSetupFilename = "nodesSetup.json"
 const txgenAccountsFileName = "accounts.json"
 const delegatorsFileName = "delegators.pem"
+const walletMnemonicFileName = "walletMnemonic.json"
+const shardMapFileName = "shardMap.json"
+const genesisSmartContractsFileName = "genesisSmartContracts.json"
+const walletKeystoreDirectory = "walletKeys"
+const delegatorsKeystoreDirectory = "delegators"
 
-// CreateOutputHandlerArgument will create an output handler argument
+// CreateOutputHandlerArgument will create an output handler argument. The files containing secret keys will only be
+// readable by their owner. The wallet and delegator keys will be written as encrypted keystores, protected by the
+// provided passphrase and salted out of the provided random reader, unless the PEM wallet key format is requested
 func CreateOutputHandlerArgument(
 	outputDirectory string,
 	validatorPubKeyConverter mxCore.PubkeyConverter,
@@ -23,17 +32,37 @@ func CreateOutputHandlerArgument(
 	shardCoordinator sharding.Coordinator,
 	shouldOutputTxgenAccountsFile bool,
 	shouldOutputDelegatorsFile bool,
+	shouldOutputWalletMnemonicFile bool,
+	walletKeyFormat string,
+	keystorePassphrase string,
+	keystoreRandReader io.Reader,
+	outputLayout string,
 ) (ArgOutputHandler, error) {
 	aoh := ArgOutputHandler{
 		ValidatorPubKeyConverter: validatorPubKeyConverter,
 		WalletPubKeyConverter:    walletPubKeyConverter,
 		ShardCoordinator:         shardCoordinator,
+		OutputDirectory:          outputDirectory,
 	}
 
 	var err error
-	aoh.WalletHandler, err = core.NewFileHandler(outputDirectory, walletKeyFileName)
-	if err != nil {
-		return ArgOutputHandler{}, fmt.Errorf("%w for WalletHandler", err)
+	switch walletKeyFormat {
+	case core.KeystoreWalletKeyFormat:
+		aoh.WalletKeystoreHandler, err = core.NewKeystoreHandler(
+			filepath.Join(outputDirectory, walletKeystoreDirectory),
+			keystorePassphrase,
+			keystoreRandReader,
+		)
+		if err != nil {
+			return ArgOutputHandler{}, fmt.Errorf("%w for WalletKeystoreHandler", err)
+		}
+	case core.PemWalletKeyFormat:
+		aoh.WalletHandler, err = core.NewSecretFileHandler(outputDirectory, walletKeyFileName)
+		if err != nil {
+			return ArgOutputHandler{}, fmt.Errorf("%w for WalletHandler", err)
+		}
+	default:
+		return ArgOutputHandler{}, fmt.Errorf("%w %s", ErrUnknownWalletKeyFormat, walletKeyFormat)
 	}
 	aoh.NodesSetupHandler, err = core.NewFileHandler(outputDirectory, nodesSetupFilename)
 	if err != nil {
@@ -43,23 +72,54 @@ func CreateOutputHandlerArgument(
 	if err != nil {
 		return ArgOutputHandler{}, fmt.Errorf("%w for GenesisHandler", err)
 	}
-	aoh.ValidatorKeyHandler, err = core.NewFileHandler(outputDirectory, validatorKeyFileName)
+	aoh.ShardMapHandler, err = core.NewFileHandler(outputDirectory, shardMapFileName)
+	if err != nil {
+		return ArgOutputHandler{}, fmt.Errorf("%w for ShardMapHandler", err)
+	}
+	aoh.GenesisSmartContractsHandler, err = core.NewFileHandler(outputDirectory, genesisSmartContractsFileName)
 	if err != nil {
-		return ArgOutputHandler{}, fmt.Errorf("%w for ValidatorKeyHandler", err)
+		return ArgOutputHandler{}, fmt.Errorf("%w for GenesisSmartContractsHandler", err)
+	}
+	switch outputLayout {
+	case core.ClassicOutputLayout:
+		aoh.ValidatorKeyHandler, err = core.NewSecretFileHandler(outputDirectory, validatorKeyFileName)
+		if err != nil {
+			return ArgOutputHandler{}, fmt.Errorf("%w for ValidatorKeyHandler", err)
+		}
+	case core.PerNodeOutputLayout:
+		aoh.PerNodeLayout = true
+	default:
+		return ArgOutputHandler{}, fmt.Errorf("%w %s", ErrUnknownOutputLayout, outputLayout)
 	}
 
 	if shouldOutputTxgenAccountsFile {
-		aoh.TxgenAccountsHandler, err = core.NewFileHandler(outputDirectory, txgenAccountsFileName)
+		aoh.TxgenAccountsHandler, err = core.NewSecretFileHandler(outputDirectory, txgenAccountsFileName)
 		if err != nil {
 			return ArgOutputHandler{}, fmt.Errorf("%w for TxgenAccountsHandler", err)
 		}
 	}
-	if shouldOutputDelegatorsFile {
-		aoh.DelegatorsHandler, err = core.NewFileHandler(outputDirectory, delegatorsFileName)
+	if shouldOutputDelegatorsFile && walletKeyFormat == core.KeystoreWalletKeyFormat {
+		aoh.DelegatorsKeystoreHandler, err = core.NewKeystoreHandler(
+			filepath.Join(outputDirectory, delegatorsKeystoreDirectory),
+			keystorePassphrase,
+			keystoreRandReader,
+		)
+		if err != nil {
+			return ArgOutputHandler{}, fmt.Errorf("%w for DelegatorsKeystoreHandler", err)
+		}
+	}
+	if shouldOutputDelegatorsFile && walletKeyFormat == core.PemWalletKeyFormat {
+		aoh.DelegatorsHandler, err = core.NewSecretFileHandler(outputDirectory, delegatorsFileName)
 		if err != nil {
 			return ArgOutputHandler{}, fmt.Errorf("%w for DelegatorsHandler", err)
 		}
 	}
+	if shouldOutputWalletMnemonicFile {
+		aoh.WalletMnemonicHandler, err = core.NewSecretFileHandler(outputDirectory, walletMnemonicFileName)
+		if err != nil {
+			return ArgOutputHandler{}, fmt.Errorf("%w for WalletMnemonicHandler", err)
+		}
+	}
 
 	return aoh, nil
 }
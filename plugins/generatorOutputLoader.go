@@ -0,0 +1,334 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	deployCore "github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+const keystoreFilesPattern = "*.json"
+
+// LoadGeneratorOutput will assemble back the generator output the files were written from, so that the network can be
+// extended. The validators are the nodes setup initial nodes, in their original order, while the loaded validator keys
+// missing from the nodes setup are the observers. The wallet keys stored in encrypted keystore files and the
+// additional accounts are loaded without their secret keys. The keystore passphrase is only used for decrypting the
+// wallet mnemonic
+func (ol *outputLoader) LoadGeneratorOutput(keystorePassphrase string) (*data.GeneratorOutput, error) {
+	initialAccounts, err := ol.LoadInitialAccounts()
+	if err != nil {
+		return nil, err
+	}
+	nodesSetup, err := ol.LoadNodesSetup()
+	if err != nil {
+		return nil, err
+	}
+	blsKeys, err := ol.LoadValidatorKeys()
+	if err != nil {
+		return nil, err
+	}
+	walletKeys, err := ol.loadOptionalWalletKeys(walletKeyFileName, walletKeystoreDirectory, initialAccounts)
+	if err != nil {
+		return nil, err
+	}
+	delegatorKeys, err := ol.loadOptionalWalletKeys(delegatorsFileName, delegatorsKeystoreDirectory, initialAccounts)
+	if err != nil {
+		return nil, err
+	}
+	additionalKeys, err := ol.LoadAdditionalKeys()
+	if errors.Is(err, os.ErrNotExist) {
+		additionalKeys, err = make([]*data.WalletKey, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	walletMnemonic, err := ol.LoadWalletMnemonic(keystorePassphrase)
+	if errors.Is(err, os.ErrNotExist) {
+		walletMnemonic, err = &WalletMnemonic{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	genesisContracts, err := ol.LoadGenesisSmartContracts()
+	if errors.Is(err, os.ErrNotExist) {
+		genesisContracts, err = make([]*mxData.InitialSmartContract, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	gen := &data.GeneratorOutput{
+		InitialAccounts: initialAccounts,
+		Mnemonic:        walletMnemonic.Mnemonic,
+	}
+	gen.InitialNodes, gen.ValidatorBlsKeys, gen.ObserverBlsKeys, err = ol.splitBlsKeys(nodesSetup.InitialNodes, blsKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalKeys, err = ol.appendMnemonicAdditionalKeys(additionalKeys, walletMnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	derivationIndexes := make(map[string]uint32, len(walletMnemonic.Accounts))
+	for _, account := range walletMnemonic.Accounts {
+		derivationIndexes[account.Address] = account.Index
+	}
+	for _, keys := range [][]*data.WalletKey{walletKeys, delegatorKeys, additionalKeys} {
+		err = ol.fillWalletKeys(keys, initialAccounts, gen.ValidatorBlsKeys, gen.InitialNodes, derivationIndexes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	gen.WalletKeys = walletKeys
+	gen.DelegatorKeys = delegatorKeys
+	gen.AdditionalKeys = additionalKeys
+
+	gen.DelegationContracts, err = ol.computeDelegationContracts(genesisContracts)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen, nil
+}
+
+// loadOptionalWalletKeys will load the wallet keys either from the PEM file or, without their secret keys, from the
+// keystore files. No key is returned if neither of them exists
+func (ol *outputLoader) loadOptionalWalletKeys(
+	fileName string,
+	keystoreDirectory string,
+	initialAccounts []mxData.InitialAccount,
+) ([]*data.WalletKey, error) {
+	keys, err := ol.loadWalletKeys(fileName, keystoreDirectory)
+	if errors.Is(err, ErrEncryptedKeystoreFiles) {
+		return ol.loadKeystorePublicKeys(keystoreDirectory, initialAccounts)
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return make([]*data.WalletKey, 0), nil
+	}
+
+	return keys, err
+}
+
+// loadKeystorePublicKeys will load the public keys of the keystore files, each of them being required to have a
+// genesis account, so a keystore left in the directory by another network is reported together with its file
+func (ol *outputLoader) loadKeystorePublicKeys(
+	keystoreDirectory string,
+	initialAccounts []mxData.InitialAccount,
+) ([]*data.WalletKey, error) {
+	filePaths, err := filepath.Glob(filepath.Join(ol.filePath(keystoreDirectory), keystoreFilesPattern))
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]struct{}, len(initialAccounts))
+	for _, account := range initialAccounts {
+		addresses[account.Address] = struct{}{}
+	}
+
+	keys := make([]*data.WalletKey, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		keystore := &deployCore.EncryptedKeyJSON{}
+		err = core.LoadJsonFile(keystore, filePath)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading %s", err, filePath)
+		}
+
+		pkBytes, errDecode := ol.walletPubKeyConverter.Decode(keystore.Bech32)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w while loading %s", errDecode, filePath)
+		}
+		_, found := addresses[keystore.Bech32]
+		if !found {
+			return nil, fmt.Errorf("%w for address %s of the keystore file %s", ErrMissingGenesisAccount,
+				keystore.Bech32, filePath)
+		}
+		keys = append(keys, &data.WalletKey{
+			PubKeyBytes: pkBytes,
+		})
+	}
+
+	return keys, nil
+}
+
+// splitBlsKeys will return the initial nodes with their BLS keys, the secret keys being set if found, and the
+// observers BLS keys
+func (ol *outputLoader) splitBlsKeys(
+	nodes []*sharding.InitialNode,
+	blsKeys []*data.BlsKey,
+) ([]*sharding.InitialNode, []*data.BlsKey, []*data.BlsKey, error) {
+	secretKeys := make(map[string][]byte, len(blsKeys))
+	for _, key := range blsKeys {
+		secretKeys[string(key.PubKeyBytes)] = key.PrivKeyBytes
+	}
+
+	initialNodes := make([]*sharding.InitialNode, 0, len(nodes))
+	validatorBlsKeys := make([]*data.BlsKey, 0, len(nodes))
+	for _, node := range nodes {
+		pkBytes, err := ol.validatorPubKeyConverter.Decode(node.PubKey)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%w for the initial node %s", err, node.PubKey)
+		}
+
+		initialNodes = append(initialNodes, &sharding.InitialNode{
+			PubKey:        node.PubKey,
+			Address:       node.Address,
+			InitialRating: node.InitialRating,
+		})
+		validatorBlsKeys = append(validatorBlsKeys, &data.BlsKey{
+			PubKeyBytes:  pkBytes,
+			PrivKeyBytes: secretKeys[string(pkBytes)],
+		})
+		delete(secretKeys, string(pkBytes))
+	}
+
+	observerBlsKeys := make([]*data.BlsKey, 0, len(secretKeys))
+	for _, key := range blsKeys {
+		_, isObserver := secretKeys[string(key.PubKeyBytes)]
+		if isObserver {
+			observerBlsKeys = append(observerBlsKeys, key)
+		}
+	}
+
+	return initialNodes, validatorBlsKeys, observerBlsKeys, nil
+}
+
+// appendMnemonicAdditionalKeys will append the additional accounts found only in the wallet mnemonic file, as the
+// txgen accounts file is optional
+func (ol *outputLoader) appendMnemonicAdditionalKeys(
+	additionalKeys []*data.WalletKey,
+	walletMnemonic *WalletMnemonic,
+) ([]*data.WalletKey, error) {
+	existing := make(map[string]struct{}, len(additionalKeys))
+	for _, key := range additionalKeys {
+		existing[string(key.PubKeyBytes)] = struct{}{}
+	}
+
+	for _, account := range walletMnemonic.Accounts {
+		if account.Kind != additionalAccountKind {
+			continue
+		}
+
+		pkBytes, err := ol.walletPubKeyConverter.Decode(account.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the wallet mnemonic account %s", err, account.Address)
+		}
+		_, found := existing[string(pkBytes)]
+		if found {
+			continue
+		}
+
+		existing[string(pkBytes)] = struct{}{}
+		additionalKeys = append(additionalKeys, &data.WalletKey{
+			PubKeyBytes: pkBytes,
+		})
+	}
+
+	return additionalKeys, nil
+}
+
+// fillWalletKeys will set the values of each wallet key out of its genesis account, together with the BLS keys of the
+// nodes it owns and its derivation index. The keys are sorted in the order of their genesis accounts
+func (ol *outputLoader) fillWalletKeys(
+	keys []*data.WalletKey,
+	initialAccounts []mxData.InitialAccount,
+	validatorBlsKeys []*data.BlsKey,
+	initialNodes []*sharding.InitialNode,
+	derivationIndexes map[string]uint32,
+) error {
+	accountsIndexes := make(map[string]int, len(initialAccounts))
+	for i, account := range initialAccounts {
+		accountsIndexes[account.Address] = i
+	}
+	ownedBlsKeys := make(map[string][]*data.BlsKey)
+	for i, node := range initialNodes {
+		ownedBlsKeys[node.Address] = append(ownedBlsKeys[node.Address], validatorBlsKeys[i])
+	}
+
+	for _, key := range keys {
+		address, _ := ol.walletPubKeyConverter.Encode(key.PubKeyBytes)
+		index, found := accountsIndexes[address]
+		if !found {
+			return fmt.Errorf("%w for address %s", ErrMissingGenesisAccount, address)
+		}
+
+		account := initialAccounts[index]
+		key.Balance = copyValue(account.Balance)
+		key.StakedValue = copyValue(account.StakingValue)
+		key.DelegatedValue = copyValue(account.Delegation.Value)
+		if len(account.Delegation.Address) > 0 {
+			delegatedPkBytes, err := ol.walletPubKeyConverter.Decode(account.Delegation.Address)
+			if err != nil {
+				return fmt.Errorf("%w for the delegation address of %s", err, address)
+			}
+			key.DelegatedPubKeyBytes = delegatedPkBytes
+		}
+		key.BlsKeys = ownedBlsKeys[address]
+		key.DerivationIndex = derivationIndexes[address]
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		addressI, _ := ol.walletPubKeyConverter.Encode(keys[i].PubKeyBytes)
+		addressJ, _ := ol.walletPubKeyConverter.Encode(keys[j].PubKeyBytes)
+
+		return accountsIndexes[addressI] < accountsIndexes[addressJ]
+	})
+
+	return nil
+}
+
+// computeDelegationContracts will compute the delegation contracts out of the genesis smart contracts. The node
+// deploys the contracts of an owner with the nonces 0, 1, 2... in the order they are defined
+func (ol *outputLoader) computeDelegationContracts(
+	genesisContracts []*mxData.InitialSmartContract,
+) ([]*data.DelegationContract, error) {
+	ownersNonces := make(map[string]uint64)
+	contracts := make([]*data.DelegationContract, 0, len(genesisContracts))
+	for _, genesisContract := range genesisContracts {
+		nonce := ownersNonces[genesisContract.Owner]
+		ownersNonces[genesisContract.Owner]++
+		if genesisContract.Type != delegationContractType {
+			continue
+		}
+
+		ownerPkBytes, err := ol.walletPubKeyConverter.Decode(genesisContract.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the genesis smart contract owner %s", err, genesisContract.Owner)
+		}
+		scAddress, err := deployCore.GenerateSCAddress(genesisContract.Owner, nonce, genesisContract.VmType,
+			ol.walletPubKeyConverter)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the genesis smart contract owner %s", err, genesisContract.Owner)
+		}
+		scPkBytes, err := ol.walletPubKeyConverter.Decode(scAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, &data.DelegationContract{
+			OwnerPubKeyBytes: ownerPkBytes,
+			OwnerNonce:       nonce,
+			ScPubKeyBytes:    scPkBytes,
+			VmType:           genesisContract.VmType,
+		})
+	}
+
+	return contracts, nil
+}
+
+func copyValue(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
@@ -0,0 +1,67 @@
+package plugins
+
+import (
+	"crypto/rand"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	deployCore "github.com/multiversx/mx-chain-deploy-go/core"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestKeystores(t *testing.T, directory string, fills ...byte) {
+	converter := createTestWalletPubKeyConverter(t)
+	kh, err := deployCore.NewKeystoreHandler(directory, "passphrase", rand.Reader)
+	require.Nil(t, err)
+
+	for _, fill := range fills {
+		err = kh.SaveSkToKeystoreFile(encodeTestAddress(t, converter, fill), createTestPubKeyBytes(fill),
+			createTestPubKeyBytes(fill))
+		require.Nil(t, err)
+	}
+}
+
+func TestOutputLoader_LoadKeystorePublicKeys(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestWalletPubKeyConverter(t)
+	initialAccounts := []mxData.InitialAccount{
+		{Address: encodeTestAddress(t, converter, 1)},
+		{Address: encodeTestAddress(t, converter, 2)},
+	}
+
+	t.Run("keystore without genesis account should error", func(t *testing.T) {
+		t.Parallel()
+
+		outputDirectory := t.TempDir()
+		writeTestKeystores(t, filepath.Join(outputDirectory, walletKeystoreDirectory), 1, 3)
+
+		ol := &outputLoader{
+			outputDirectory:       outputDirectory,
+			walletPubKeyConverter: converter,
+		}
+		keys, err := ol.loadKeystorePublicKeys(walletKeystoreDirectory, initialAccounts)
+		assert.Nil(t, keys)
+		assert.True(t, errors.Is(err, ErrMissingGenesisAccount))
+		assert.Contains(t, err.Error(), encodeTestAddress(t, converter, 3))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		outputDirectory := t.TempDir()
+		writeTestKeystores(t, filepath.Join(outputDirectory, walletKeystoreDirectory), 1, 2)
+
+		ol := &outputLoader{
+			outputDirectory:       outputDirectory,
+			walletPubKeyConverter: converter,
+		}
+		keys, err := ol.loadKeystorePublicKeys(walletKeystoreDirectory, initialAccounts)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(keys))
+		assert.Nil(t, keys[0].PrivKeyBytes)
+		assert.Nil(t, keys[1].PrivKeyBytes)
+	})
+}
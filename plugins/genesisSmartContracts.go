@@ -0,0 +1,72 @@
+package plugins
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+)
+
+const delegationContractType = "delegation"
+const delegationContractFilename = "./config/genesisContracts/delegation.wasm"
+const validatorScAddressPlaceholder = "%validator_sc_address%"
+const argumentsSeparator = "@"
+
+// maxDelegationServiceFee is the service fee, expressed in hundredths of a percent, equivalent to 100%
+const maxDelegationServiceFee = 10000
+
+// writeGenesisSmartContracts will write the genesis smart contracts file containing the definition of each
+// delegation contract. The node computes the contract addresses from the owners addresses and nonces, in the order the
+// contracts are defined, so the generated contracts are expected to be sorted by the owners nonces
+func (oh *outputHandler) writeGenesisSmartContracts(contracts []*data.DelegationContract) error {
+	if check.IfNil(oh.genesisSmartContractsHandler) {
+		log.Debug("can not write to genesis smart contracts file as it is nil")
+		return nil
+	}
+
+	initParameters := oh.computeDelegationInitParameters()
+	genesisContracts := make([]*mxData.InitialSmartContract, 0, len(contracts))
+	for _, contract := range contracts {
+		ownerAddress, _ := oh.walletPubKeyConverter.Encode(contract.OwnerPubKeyBytes)
+		scAddress, _ := oh.walletPubKeyConverter.Encode(contract.ScPubKeyBytes)
+		log.Debug("delegation contract", "owner", ownerAddress, "nonce", contract.OwnerNonce, "address", scAddress)
+
+		genesisContracts = append(genesisContracts, &mxData.InitialSmartContract{
+			Owner:          ownerAddress,
+			Filename:       delegationContractFilename,
+			VmType:         contract.VmType,
+			InitParameters: initParameters,
+			Type:           delegationContractType,
+			Version:        oh.delegationVersion,
+		})
+	}
+
+	return oh.genesisSmartContractsHandler.WriteObjectInFile(genesisContracts)
+}
+
+// computeDelegationInitParameters returns the custom init parameters, if provided. Otherwise, the init parameters are
+// built out of the validator system SC address placeholder, the service fee and the delegation cap
+func (oh *outputHandler) computeDelegationInitParameters() string {
+	if len(oh.delegationInitParameters) > 0 {
+		return oh.delegationInitParameters
+	}
+
+	arguments := []string{
+		validatorScAddressPlaceholder,
+		toHexArgument(big.NewInt(0).SetUint64(oh.delegationServiceFee)),
+		toHexArgument(oh.delegationCap),
+	}
+
+	return strings.Join(arguments, argumentsSeparator)
+}
+
+func toHexArgument(value *big.Int) string {
+	if value.Sign() == 0 {
+		return "00"
+	}
+
+	return strings.ToUpper(hex.EncodeToString(value.Bytes()))
+}
@@ -1,9 +1,32 @@
 package plugins
 
+import (
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
 // FileHandler describes the file handling capabilities
 type FileHandler interface {
 	WriteObjectInFile(data interface{}) error
+	WriteObjectLineInFile(data interface{}) error
 	SaveSkToPemFile(identifier string, skBytes []byte) error
 	Close()
 	IsInterfaceNil() bool
 }
+
+// KeystoreHandler describes the capability of saving secret keys in encrypted keystore files
+type KeystoreHandler interface {
+	SaveSkToKeystoreFile(identifier string, pkBytes []byte, skBytes []byte) error
+	KeepKeystoreFile(identifier string) error
+	RemoveStaleKeystoreFiles() error
+	EncryptMnemonic(mnemonic string) (*core.EncryptedKeyJSON, error)
+	Close()
+	IsInterfaceNil() bool
+}
+
+// OutputWriter describes a sink of the generated output
+type OutputWriter interface {
+	WriteData(generatedOutput data.GeneratorOutput) error
+	Close()
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,33 @@
+package plugins
+
+import (
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+type multipleOutputWriter struct {
+	writers []OutputWriter
+}
+
+// WriteData will feed the generated output to all the inner writers, stopping at the first error
+func (mow *multipleOutputWriter) WriteData(generatedOutput data.GeneratorOutput) error {
+	for _, writer := range mow.writers {
+		err := writer.WriteData(generatedOutput)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes all inner writers
+func (mow *multipleOutputWriter) Close() {
+	for _, writer := range mow.writers {
+		writer.Close()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mow *multipleOutputWriter) IsInterfaceNil() bool {
+	return mow == nil
+}
@@ -0,0 +1,82 @@
+package plugins
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	"github.com/multiversx/mx-chain-deploy-go/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultipleOutputWriter_WriteData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failing writer should stop the propagation", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		calls := make([]int, 3)
+		createWriter := func(index int, err error) *mock.OutputWriterStub {
+			return &mock.OutputWriterStub{
+				WriteDataCalled: func(generatedOutput data.GeneratorOutput) error {
+					calls[index]++
+					return err
+				},
+			}
+		}
+
+		mow := &multipleOutputWriter{
+			writers: []OutputWriter{
+				createWriter(0, nil),
+				createWriter(1, expectedErr),
+				createWriter(2, nil),
+			},
+		}
+
+		err := mow.WriteData(data.GeneratorOutput{})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, []int{1, 1, 0}, calls)
+	})
+	t.Run("should feed all the writers", func(t *testing.T) {
+		t.Parallel()
+
+		generatedOutput := data.GeneratorOutput{
+			Mnemonic: "mnemonic",
+		}
+		numCalls := 0
+		writer := &mock.OutputWriterStub{
+			WriteDataCalled: func(providedOutput data.GeneratorOutput) error {
+				assert.Equal(t, generatedOutput, providedOutput)
+				numCalls++
+				return nil
+			},
+		}
+
+		mow := &multipleOutputWriter{
+			writers: []OutputWriter{writer, writer},
+		}
+
+		err := mow.WriteData(generatedOutput)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numCalls)
+	})
+}
+
+func TestMultipleOutputWriter_CloseShouldCloseAllTheWriters(t *testing.T) {
+	t.Parallel()
+
+	numCloseCalls := 0
+	writer := &mock.OutputWriterStub{
+		CloseCalled: func() {
+			numCloseCalls++
+		},
+	}
+
+	mow := &multipleOutputWriter{
+		writers: []OutputWriter{writer, writer, writer},
+	}
+	mow.Close()
+
+	assert.Equal(t, 3, numCloseCalls)
+}
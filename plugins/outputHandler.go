@@ -6,6 +6,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	deployCore "github.com/multiversx/mx-chain-deploy-go/core"
 	"github.com/multiversx/mx-chain-deploy-go/data"
 	mxData "github.com/multiversx/mx-chain-go/genesis/data"
 	"github.com/multiversx/mx-chain-go/sharding"
@@ -14,51 +15,82 @@ import (
 
 var log = logger.GetOrCreate("io")
 
+const walletMnemonicDerivationPath = "m/44'/508'/0'/0'/{index}'"
+const ownerAccountKind = "owner"
+const delegatorAccountKind = "delegator"
+const additionalAccountKind = "additional"
+
 // ArgOutputHandler represents the output handler constructor argument
 type ArgOutputHandler struct {
-	WalletHandler               FileHandler
-	ValidatorKeyHandler         FileHandler
-	GenesisHandler              FileHandler
-	NodesSetupHandler           FileHandler
-	TxgenAccountsHandler        FileHandler
-	DelegatorsHandler           FileHandler
-	ValidatorPubKeyConverter    core.PubkeyConverter
-	WalletPubKeyConverter       core.PubkeyConverter
-	ShardCoordinator            sharding.Coordinator
-	RoundDuration               uint64
-	ConsensusGroupSize          int
-	NumOfNodesPerShard          int
-	MetachainConsensusGroupSize int
-	NumOfMetachainNodes         int
-	HysteresisValue             float32
-	AdaptivityValue             bool
+	WalletHandler                FileHandler
+	ValidatorKeyHandler          FileHandler
+	GenesisHandler               FileHandler
+	NodesSetupHandler            FileHandler
+	TxgenAccountsHandler         FileHandler
+	DelegatorsHandler            FileHandler
+	WalletMnemonicHandler        FileHandler
+	ShardMapHandler              FileHandler
+	GenesisSmartContractsHandler FileHandler
+	WalletKeystoreHandler        KeystoreHandler
+	DelegatorsKeystoreHandler    KeystoreHandler
+	ValidatorPubKeyConverter     core.PubkeyConverter
+	WalletPubKeyConverter        core.PubkeyConverter
+	ShardCoordinator             sharding.Coordinator
+	OutputDirectory              string
+	PerNodeLayout                bool
+	RoundDuration                uint64
+	ConsensusGroupSize           int
+	NumOfNodesPerShard           int
+	MetachainConsensusGroupSize  int
+	NumOfMetachainNodes          int
+	NumOfObserversPerShard       int
+	NumOfMetachainObservers      int
+	HysteresisValue              float32
+	AdaptivityValue              bool
+	DelegationServiceFee         uint64
+	DelegationCap                *big.Int
+	DelegationInitParameters     string
+	DelegationVersion            string
 }
 
 type outputHandler struct {
-	walletHandler               FileHandler
-	validatorKeyHandler         FileHandler
-	genesisHandler              FileHandler
-	nodesSetupHandler           FileHandler
-	txgenAccountsHandler        FileHandler
-	delegatorsHandler           FileHandler
-	validatorPubKeyConverter    core.PubkeyConverter
-	walletPubKeyConverter       core.PubkeyConverter
-	shardCoordinator            sharding.Coordinator
-	roundDuration               uint64
-	consensusGroupSize          int
-	numOfNodesPerShard          int
-	metachainConsensusGroupSize int
-	numOfMetachainNodes         int
-	hysteresisValue             float32
-	adaptivityValue             bool
+	walletHandler                FileHandler
+	validatorKeyHandler          FileHandler
+	genesisHandler               FileHandler
+	nodesSetupHandler            FileHandler
+	txgenAccountsHandler         FileHandler
+	delegatorsHandler            FileHandler
+	walletMnemonicHandler        FileHandler
+	shardMapHandler              FileHandler
+	genesisSmartContractsHandler FileHandler
+	walletKeystoreHandler        KeystoreHandler
+	delegatorsKeystoreHandler    KeystoreHandler
+	validatorPubKeyConverter     core.PubkeyConverter
+	walletPubKeyConverter        core.PubkeyConverter
+	shardCoordinator             sharding.Coordinator
+	outputDirectory              string
+	perNodeLayout                bool
+	roundDuration                uint64
+	consensusGroupSize           int
+	numOfNodesPerShard           int
+	metachainConsensusGroupSize  int
+	numOfMetachainNodes          int
+	numOfObserversPerShard       int
+	numOfMetachainObservers      int
+	hysteresisValue              float32
+	adaptivityValue              bool
+	delegationServiceFee         uint64
+	delegationCap                *big.Int
+	delegationInitParameters     string
+	delegationVersion            string
 }
 
 // NewOutputHandler will create a new output handler able to write data on disk
 func NewOutputHandler(arg ArgOutputHandler) (*outputHandler, error) {
-	if check.IfNil(arg.WalletHandler) {
-		return nil, fmt.Errorf("%w for WalletHandler", ErrNilFileHandler)
+	if check.IfNil(arg.WalletHandler) && check.IfNil(arg.WalletKeystoreHandler) {
+		return nil, fmt.Errorf("%w for WalletHandler and WalletKeystoreHandler", ErrNilFileHandler)
 	}
-	if check.IfNil(arg.ValidatorKeyHandler) {
+	if check.IfNil(arg.ValidatorKeyHandler) && !arg.PerNodeLayout {
 		return nil, fmt.Errorf("%w for ValidatorKeyHandler", ErrNilFileHandler)
 	}
 	if check.IfNil(arg.GenesisHandler) {
@@ -67,7 +99,8 @@ func NewOutputHandler(arg ArgOutputHandler) (*outputHandler, error) {
 	if check.IfNil(arg.NodesSetupHandler) {
 		return nil, fmt.Errorf("%w for NodesSetupHandler", ErrNilFileHandler)
 	}
-	// TxgenAccountsHandler and DelegatorsHandler can be nil
+	// TxgenAccountsHandler, DelegatorsHandler, WalletMnemonicHandler, ShardMapHandler, GenesisSmartContractsHandler and
+	// DelegatorsKeystoreHandler can be nil
 
 	if check.IfNil(arg.ValidatorPubKeyConverter) {
 		return nil, fmt.Errorf("%w for ValidatorPubKeyConverter", ErrNilPubKeyConverter)
@@ -78,24 +111,44 @@ func NewOutputHandler(arg ArgOutputHandler) (*outputHandler, error) {
 	if check.IfNil(arg.ShardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
+	if arg.DelegationServiceFee > maxDelegationServiceFee {
+		return nil, fmt.Errorf("%w %d, maximum: %d", ErrInvalidDelegationServiceFee,
+			arg.DelegationServiceFee, maxDelegationServiceFee)
+	}
+	if arg.DelegationCap == nil || arg.DelegationCap.Sign() < 0 {
+		return nil, ErrInvalidDelegationCap
+	}
 
 	return &outputHandler{
-		walletHandler:               arg.WalletHandler,
-		validatorKeyHandler:         arg.ValidatorKeyHandler,
-		genesisHandler:              arg.GenesisHandler,
-		nodesSetupHandler:           arg.NodesSetupHandler,
-		txgenAccountsHandler:        arg.TxgenAccountsHandler,
-		delegatorsHandler:           arg.DelegatorsHandler,
-		validatorPubKeyConverter:    arg.ValidatorPubKeyConverter,
-		walletPubKeyConverter:       arg.WalletPubKeyConverter,
-		shardCoordinator:            arg.ShardCoordinator,
-		roundDuration:               arg.RoundDuration,
-		consensusGroupSize:          arg.ConsensusGroupSize,
-		numOfNodesPerShard:          arg.NumOfNodesPerShard,
-		metachainConsensusGroupSize: arg.MetachainConsensusGroupSize,
-		numOfMetachainNodes:         arg.NumOfMetachainNodes,
-		hysteresisValue:             arg.HysteresisValue,
-		adaptivityValue:             arg.AdaptivityValue,
+		walletHandler:                arg.WalletHandler,
+		validatorKeyHandler:          arg.ValidatorKeyHandler,
+		genesisHandler:               arg.GenesisHandler,
+		nodesSetupHandler:            arg.NodesSetupHandler,
+		txgenAccountsHandler:         arg.TxgenAccountsHandler,
+		delegatorsHandler:            arg.DelegatorsHandler,
+		walletMnemonicHandler:        arg.WalletMnemonicHandler,
+		shardMapHandler:              arg.ShardMapHandler,
+		genesisSmartContractsHandler: arg.GenesisSmartContractsHandler,
+		walletKeystoreHandler:        arg.WalletKeystoreHandler,
+		delegatorsKeystoreHandler:    arg.DelegatorsKeystoreHandler,
+		validatorPubKeyConverter:     arg.ValidatorPubKeyConverter,
+		walletPubKeyConverter:        arg.WalletPubKeyConverter,
+		shardCoordinator:             arg.ShardCoordinator,
+		outputDirectory:              arg.OutputDirectory,
+		perNodeLayout:                arg.PerNodeLayout,
+		roundDuration:                arg.RoundDuration,
+		consensusGroupSize:           arg.ConsensusGroupSize,
+		numOfNodesPerShard:           arg.NumOfNodesPerShard,
+		metachainConsensusGroupSize:  arg.MetachainConsensusGroupSize,
+		numOfMetachainNodes:          arg.NumOfMetachainNodes,
+		numOfObserversPerShard:       arg.NumOfObserversPerShard,
+		numOfMetachainObservers:      arg.NumOfMetachainObservers,
+		hysteresisValue:              arg.HysteresisValue,
+		adaptivityValue:              arg.AdaptivityValue,
+		delegationServiceFee:         arg.DelegationServiceFee,
+		delegationCap:                big.NewInt(0).Set(arg.DelegationCap),
+		delegationInitParameters:     arg.DelegationInitParameters,
+		delegationVersion:            arg.DelegationVersion,
 	}, nil
 }
 
@@ -131,6 +184,10 @@ func (oh *outputHandler) writeValidatorKeys(
 
 	for _, key := range keys {
 		pkString, _ := oh.validatorPubKeyConverter.Encode(key.PubKeyBytes)
+		if len(key.PrivKeyBytes) == 0 {
+			log.Debug("skipping validator key as its secret key is not known", "pk", pkString)
+			continue
+		}
 
 		err := oh.validatorKeyHandler.SaveSkToPemFile(pkString, key.PrivKeyBytes)
 		if err != nil {
@@ -143,35 +200,55 @@ func (oh *outputHandler) writeValidatorKeys(
 
 // writeWalletKeys will write the wallet keys
 func (oh *outputHandler) writeWalletKeys(walletKeys []*data.WalletKey) error {
-	for _, key := range walletKeys {
-		pkString, _ := oh.walletPubKeyConverter.Encode(key.PubKeyBytes)
-
-		err := oh.walletHandler.SaveSkToPemFile(pkString, key.PrivKeyBytes)
-		if err != nil {
-			return fmt.Errorf("%w for pk %s", err, pkString)
-		}
-	}
-
-	return nil
+	return oh.saveWalletKeys(walletKeys, oh.walletHandler, oh.walletKeystoreHandler)
 }
 
 // writeDelegatorKeys will write the delegator keys
 func (oh *outputHandler) writeDelegatorKeys(delegatorKeys []*data.WalletKey) error {
-	if check.IfNil(oh.delegatorsHandler) {
+	if check.IfNil(oh.delegatorsHandler) && check.IfNil(oh.delegatorsKeystoreHandler) {
 		log.Debug("can not write to delegator keys file as it is nil")
 		return nil
 	}
 
-	for _, key := range delegatorKeys {
+	return oh.saveWalletKeys(delegatorKeys, oh.delegatorsHandler, oh.delegatorsKeystoreHandler)
+}
+
+// saveWalletKeys will save the wallet keys in keystore files, if the keystore handler is set, or in the PEM file.
+// The existing keystore files of the keys without secret keys are kept, while any other keystore file is removed
+func (oh *outputHandler) saveWalletKeys(
+	keys []*data.WalletKey,
+	pemHandler FileHandler,
+	keystoreHandler KeystoreHandler,
+) error {
+	for _, key := range keys {
 		pkString, _ := oh.walletPubKeyConverter.Encode(key.PubKeyBytes)
+		if len(key.PrivKeyBytes) == 0 {
+			log.Debug("skipping wallet key as its secret key is not known", "pk", pkString)
+			if !check.IfNil(keystoreHandler) {
+				err := keystoreHandler.KeepKeystoreFile(pkString)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
 
-		err := oh.delegatorsHandler.SaveSkToPemFile(pkString, key.PrivKeyBytes)
+		var err error
+		if !check.IfNil(keystoreHandler) {
+			err = keystoreHandler.SaveSkToKeystoreFile(pkString, key.PubKeyBytes, key.PrivKeyBytes)
+		} else {
+			err = pemHandler.SaveSkToPemFile(pkString, key.PrivKeyBytes)
+		}
 		if err != nil {
 			return fmt.Errorf("%w for pk %s", err, pkString)
 		}
 	}
 
-	return nil
+	if check.IfNil(keystoreHandler) {
+		return nil
+	}
+
+	return keystoreHandler.RemoveStaleKeystoreFiles()
 }
 
 // writeTxGenAccounts will write the optional txgen accounts
@@ -200,6 +277,110 @@ func (oh *outputHandler) writeTxGenAccounts(additionalKeys []*data.WalletKey) er
 	return oh.txgenAccountsHandler.WriteObjectInFile(txgenAccounts)
 }
 
+// writeWalletMnemonic will write the optional mnemonic file together with the derivation index of each wallet key. The
+// mnemonic is encrypted if the wallet keys are written as keystores
+func (oh *outputHandler) writeWalletMnemonic(generatedOutput data.GeneratorOutput) error {
+	if check.IfNil(oh.walletMnemonicHandler) {
+		log.Debug("can not write to wallet mnemonic file as it is nil")
+		return nil
+	}
+
+	numAccounts := len(generatedOutput.WalletKeys) + len(generatedOutput.DelegatorKeys) + len(generatedOutput.AdditionalKeys)
+	mnemonic := &WalletMnemonic{
+		Mnemonic:       generatedOutput.Mnemonic,
+		DerivationPath: walletMnemonicDerivationPath,
+		Accounts:       make([]*WalletMnemonicAccount, 0, numAccounts),
+	}
+	if !check.IfNil(oh.walletKeystoreHandler) {
+		encryptedMnemonic, err := oh.walletKeystoreHandler.EncryptMnemonic(generatedOutput.Mnemonic)
+		if err != nil {
+			return fmt.Errorf("%w while encrypting the wallet mnemonic", err)
+		}
+
+		mnemonic.Mnemonic = ""
+		mnemonic.EncryptedMnemonic = encryptedMnemonic
+	}
+
+	addAccounts := func(keys []*data.WalletKey, kind string) {
+		for _, key := range keys {
+			address, _ := oh.walletPubKeyConverter.Encode(key.PubKeyBytes)
+			mnemonic.Accounts = append(mnemonic.Accounts, &WalletMnemonicAccount{
+				Address: address,
+				Index:   key.DerivationIndex,
+				Kind:    kind,
+			})
+		}
+	}
+	addAccounts(generatedOutput.WalletKeys, ownerAccountKind)
+	addAccounts(generatedOutput.DelegatorKeys, delegatorAccountKind)
+	addAccounts(generatedOutput.AdditionalKeys, additionalAccountKind)
+
+	return oh.walletMnemonicHandler.WriteObjectInFile(mnemonic)
+}
+
+// computeNodesAssignment will compute the shard, role and index of each validator and observer
+func (oh *outputHandler) computeNodesAssignment(generatedOutput data.GeneratorOutput) ([]*deployCore.NodeAssignment, error) {
+	observerPubKeys := make([]string, 0, len(generatedOutput.ObserverBlsKeys))
+	for _, key := range generatedOutput.ObserverBlsKeys {
+		pkString, _ := oh.validatorPubKeyConverter.Encode(key.PubKeyBytes)
+		observerPubKeys = append(observerPubKeys, pkString)
+	}
+
+	return deployCore.ComputeNodesAssignment(deployCore.ArgNodesAssignment{
+		InitialNodes:            generatedOutput.InitialNodes,
+		ObserverPubKeys:         observerPubKeys,
+		NumOfShards:             oh.shardCoordinator.NumberOfShards(),
+		MinNodesPerShard:        uint32(oh.numOfNodesPerShard),
+		MetaChainMinNodes:       uint32(oh.numOfMetachainNodes),
+		Hysteresis:              oh.hysteresisValue,
+		NumOfObserversPerShard:  uint32(oh.numOfObserversPerShard),
+		NumOfMetachainObservers: uint32(oh.numOfMetachainObservers),
+	})
+}
+
+// writeShardMap will write the shard map manifest containing the assignment of every BLS key
+func (oh *outputHandler) writeShardMap(assignments []*deployCore.NodeAssignment) error {
+	if check.IfNil(oh.shardMapHandler) {
+		log.Debug("can not write to shard map file as it is nil")
+		return nil
+	}
+
+	manifest := &ShardMap{
+		NumOfShards: oh.shardCoordinator.NumberOfShards(),
+		Nodes:       make([]*ShardMapNode, 0, len(assignments)),
+	}
+	numNodesPerRole := make(map[uint32]map[string]int)
+	for _, assignment := range assignments {
+		manifest.Nodes = append(manifest.Nodes, &ShardMapNode{
+			PubKey:  assignment.PubKey,
+			ShardID: assignment.ShardID,
+			Role:    assignment.Role,
+			Owner:   assignment.Address,
+			Index:   assignment.Index,
+		})
+
+		if numNodesPerRole[assignment.ShardID] == nil {
+			numNodesPerRole[assignment.ShardID] = make(map[string]int)
+		}
+		numNodesPerRole[assignment.ShardID][assignment.Role]++
+	}
+
+	shardIDs := make([]uint32, 0, manifest.NumOfShards+1)
+	for shardID := uint32(0); shardID < manifest.NumOfShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+	for _, shardID := range shardIDs {
+		log.Info("shard composition", "shard", shardID,
+			"eligible", numNodesPerRole[shardID][deployCore.EligibleRole],
+			"waiting", numNodesPerRole[shardID][deployCore.WaitingRole],
+			"observers", numNodesPerRole[shardID][deployCore.ObserverRole],
+		)
+	}
+
+	return oh.shardMapHandler.WriteObjectInFile(manifest)
+}
+
 // WriteData will write the generated output in the files
 func (oh *outputHandler) WriteData(generatedOutput data.GeneratorOutput) error {
 	err := oh.writeNodesSetup(generatedOutput.InitialNodes)
@@ -212,7 +393,26 @@ func (oh *outputHandler) WriteData(generatedOutput data.GeneratorOutput) error {
 		return err
 	}
 
-	err = oh.writeValidatorKeys(generatedOutput.ValidatorBlsKeys, generatedOutput.ObserverBlsKeys)
+	err = oh.writeGenesisSmartContracts(generatedOutput.DelegationContracts)
+	if err != nil {
+		return err
+	}
+
+	assignments, err := oh.computeNodesAssignment(generatedOutput)
+	if err != nil {
+		return err
+	}
+
+	err = oh.writeShardMap(assignments)
+	if err != nil {
+		return err
+	}
+
+	if oh.perNodeLayout {
+		err = oh.writePerNodeValidatorKeys(generatedOutput, assignments)
+	} else {
+		err = oh.writeValidatorKeys(generatedOutput.ValidatorBlsKeys, generatedOutput.ObserverBlsKeys)
+	}
 	if err != nil {
 		return err
 	}
@@ -232,13 +432,16 @@ func (oh *outputHandler) WriteData(generatedOutput data.GeneratorOutput) error {
 		return err
 	}
 
+	err = oh.writeWalletMnemonic(generatedOutput)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // Close closes all inner handlers
 func (oh *outputHandler) Close() {
-	oh.walletHandler.Close()
-	oh.validatorKeyHandler.Close()
 	oh.genesisHandler.Close()
 	oh.nodesSetupHandler.Close()
 	if !check.IfNil(oh.txgenAccountsHandler) {
@@ -247,6 +450,27 @@ func (oh *outputHandler) Close() {
 	if !check.IfNil(oh.delegatorsHandler) {
 		oh.delegatorsHandler.Close()
 	}
+	if !check.IfNil(oh.walletMnemonicHandler) {
+		oh.walletMnemonicHandler.Close()
+	}
+	if !check.IfNil(oh.shardMapHandler) {
+		oh.shardMapHandler.Close()
+	}
+	if !check.IfNil(oh.genesisSmartContractsHandler) {
+		oh.genesisSmartContractsHandler.Close()
+	}
+	if !check.IfNil(oh.walletHandler) {
+		oh.walletHandler.Close()
+	}
+	if !check.IfNil(oh.validatorKeyHandler) {
+		oh.validatorKeyHandler.Close()
+	}
+	if !check.IfNil(oh.walletKeystoreHandler) {
+		oh.walletKeystoreHandler.Close()
+	}
+	if !check.IfNil(oh.delegatorsKeystoreHandler) {
+		oh.delegatorsKeystoreHandler.Close()
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -0,0 +1,300 @@
+package plugins
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	deployCore "github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+	mxData "github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+// ArgOutputLoader represents the output loader constructor argument
+type ArgOutputLoader struct {
+	OutputDirectory          string
+	ValidatorKeyGenerator    crypto.KeyGenerator
+	WalletKeyGenerator       crypto.KeyGenerator
+	ValidatorPubKeyConverter core.PubkeyConverter
+	WalletPubKeyConverter    core.PubkeyConverter
+	NumOfShards              uint32
+}
+
+type outputLoader struct {
+	outputDirectory          string
+	validatorKeyGenerator    crypto.KeyGenerator
+	walletKeyGenerator       crypto.KeyGenerator
+	validatorPubKeyConverter core.PubkeyConverter
+	walletPubKeyConverter    core.PubkeyConverter
+	numOfShards              uint32
+}
+
+// NewOutputLoader will create a new output loader able to read back the files written by the output handler. The
+// optional files that were not generated are reported through errors wrapping os.ErrNotExist
+func NewOutputLoader(arg ArgOutputLoader) (*outputLoader, error) {
+	if len(arg.OutputDirectory) == 0 {
+		return nil, ErrEmptyOutputDirectory
+	}
+	if check.IfNil(arg.ValidatorKeyGenerator) {
+		return nil, fmt.Errorf("%w for ValidatorKeyGenerator", ErrNilKeyGenerator)
+	}
+	if check.IfNil(arg.WalletKeyGenerator) {
+		return nil, fmt.Errorf("%w for WalletKeyGenerator", ErrNilKeyGenerator)
+	}
+	if check.IfNil(arg.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for ValidatorPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if check.IfNil(arg.WalletPubKeyConverter) {
+		return nil, fmt.Errorf("%w for WalletPubKeyConverter", ErrNilPubKeyConverter)
+	}
+	if arg.NumOfShards == 0 {
+		return nil, ErrInvalidNumOfShards
+	}
+
+	return &outputLoader{
+		outputDirectory:          arg.OutputDirectory,
+		validatorKeyGenerator:    arg.ValidatorKeyGenerator,
+		walletKeyGenerator:       arg.WalletKeyGenerator,
+		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+		walletPubKeyConverter:    arg.WalletPubKeyConverter,
+		numOfShards:              arg.NumOfShards,
+	}, nil
+}
+
+// LoadInitialAccounts will load the initial accounts from the genesis file. A missing delegation entry is loaded as
+// an empty delegation, the same way the output handler writes it
+func (ol *outputLoader) LoadInitialAccounts() ([]mxData.InitialAccount, error) {
+	initialAccounts := make([]mxData.InitialAccount, 0)
+	err := core.LoadJsonFile(&initialAccounts, ol.filePath(genesisFilename))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading %s", err, genesisFilename)
+	}
+
+	for i := range initialAccounts {
+		if initialAccounts[i].Delegation == nil {
+			initialAccounts[i].Delegation = &mxData.DelegationData{}
+		}
+		if initialAccounts[i].Delegation.Value == nil {
+			initialAccounts[i].Delegation.Value = big.NewInt(0)
+		}
+	}
+
+	return initialAccounts, nil
+}
+
+// LoadNodesSetup will load the nodes setup file, applying the same validation as the node does when starting. The
+// number of shards is capped at the scenario's number of shards, so the hysteresis nodes do not create extra shards
+func (ol *outputLoader) LoadNodesSetup() (*sharding.NodesSetup, error) {
+	nodesSetup, err := sharding.NewNodesSetup(
+		ol.filePath(nodesSetupFilename),
+		ol.walletPubKeyConverter,
+		ol.validatorPubKeyConverter,
+		ol.numOfShards,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading %s", err, nodesSetupFilename)
+	}
+
+	return nodesSetup, nil
+}
+
+// LoadValidatorKeys will load the validator keys either from the validatorKey.pem file or, for the per node layout,
+// from all the node-<shard>-<index>/config/validatorKey.pem files. Each secret key has to derive its public key
+func (ol *outputLoader) LoadValidatorKeys() ([]*data.BlsKey, error) {
+	filePaths, err := ol.ValidatorKeysFilePaths()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*data.BlsKey, 0)
+	for _, filePath := range filePaths {
+		fileKeys, errLoad := deployCore.LoadValidatorKeys(filePath, ol.validatorKeyGenerator, ol.validatorPubKeyConverter)
+		if errLoad != nil {
+			return nil, fmt.Errorf("%w while loading %s", errLoad, filePath)
+		}
+
+		keys = append(keys, fileKeys...)
+	}
+
+	return keys, nil
+}
+
+// ValidatorKeysFilePaths returns the path of the validatorKey.pem file or, for the per node layout, the paths of all the
+// node-<shard>-<index>/config/validatorKey.pem files
+func (ol *outputLoader) ValidatorKeysFilePaths() ([]string, error) {
+	classicFilePath := ol.filePath(validatorKeyFileName)
+	_, err := os.Stat(classicFilePath)
+	if err == nil {
+		return []string{classicFilePath}, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	pattern := filepath.Join(ol.outputDirectory, "node-*", nodeConfigDirectory, validatorKeyFileName)
+	filePaths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(filePaths) == 0 {
+		return nil, fmt.Errorf("%w, neither %s nor per node validator keys were found", os.ErrNotExist,
+			validatorKeyFileName)
+	}
+	sort.Strings(filePaths)
+
+	return filePaths, nil
+}
+
+// LoadWalletKeys will load the owners wallet keys from the walletKey.pem file
+func (ol *outputLoader) LoadWalletKeys() ([]*data.WalletKey, error) {
+	return ol.loadWalletKeys(walletKeyFileName, walletKeystoreDirectory)
+}
+
+// LoadDelegatorKeys will load the delegators wallet keys from the delegators.pem file
+func (ol *outputLoader) LoadDelegatorKeys() ([]*data.WalletKey, error) {
+	return ol.loadWalletKeys(delegatorsFileName, delegatorsKeystoreDirectory)
+}
+
+// LoadWalletKeystores will load and decrypt the owners wallet keys from the walletKeys keystore directory
+func (ol *outputLoader) LoadWalletKeystores(passphrase string) ([]*data.WalletKey, error) {
+	return ol.loadWalletKeystores(walletKeystoreDirectory, passphrase)
+}
+
+// LoadDelegatorKeystores will load and decrypt the delegators wallet keys from the delegators keystore directory
+func (ol *outputLoader) LoadDelegatorKeystores(passphrase string) ([]*data.WalletKey, error) {
+	return ol.loadWalletKeystores(delegatorsKeystoreDirectory, passphrase)
+}
+
+func (ol *outputLoader) loadWalletKeystores(keystoreDirectory string, passphrase string) ([]*data.WalletKey, error) {
+	if !ol.directoryExists(keystoreDirectory) {
+		return nil, fmt.Errorf("%w, the %s directory was not found", os.ErrNotExist, keystoreDirectory)
+	}
+
+	keys, err := deployCore.LoadWalletKeystores(ol.filePath(keystoreDirectory), passphrase, ol.walletKeyGenerator,
+		ol.walletPubKeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w in the %s directory", err, keystoreDirectory)
+	}
+
+	return keys, nil
+}
+
+func (ol *outputLoader) loadWalletKeys(fileName string, keystoreDirectory string) ([]*data.WalletKey, error) {
+	filePath := ol.filePath(fileName)
+	_, err := os.Stat(filePath)
+	if os.IsNotExist(err) && ol.directoryExists(keystoreDirectory) {
+		return nil, fmt.Errorf("%w in the %s directory", ErrEncryptedKeystoreFiles, keystoreDirectory)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := deployCore.LoadWalletKeys(filePath, ol.walletKeyGenerator, ol.walletPubKeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading %s", err, fileName)
+	}
+
+	return keys, nil
+}
+
+// LoadAdditionalKeys will load the additional accounts from the optional txgen accounts file. The secret keys are
+// not loaded, as they are not stored in a recoverable form
+func (ol *outputLoader) LoadAdditionalKeys() ([]*data.WalletKey, error) {
+	txgenAccounts := make(map[uint32][]*txgenAccount)
+	err := core.LoadJsonFile(&txgenAccounts, ol.filePath(txgenAccountsFileName))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading %s", err, txgenAccountsFileName)
+	}
+
+	shardIDs := make([]uint32, 0, len(txgenAccounts))
+	for shardID := range txgenAccounts {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	keys := make([]*data.WalletKey, 0)
+	for _, shardID := range shardIDs {
+		for _, account := range txgenAccounts[shardID] {
+			pkBytes, errDecode := ol.walletPubKeyConverter.Decode(account.PubKey)
+			if errDecode != nil {
+				return nil, fmt.Errorf("%w for the txgen account %s", errDecode, account.PubKey)
+			}
+
+			balance := big.NewInt(0)
+			if account.Balance != nil {
+				balance.Set(account.Balance)
+			}
+			keys = append(keys, &data.WalletKey{
+				PubKeyBytes: pkBytes,
+				Balance:     balance,
+			})
+		}
+	}
+
+	return keys, nil
+}
+
+// LoadGenesisSmartContracts will load the optional genesis smart contracts file
+func (ol *outputLoader) LoadGenesisSmartContracts() ([]*mxData.InitialSmartContract, error) {
+	contracts := make([]*mxData.InitialSmartContract, 0)
+	err := core.LoadJsonFile(&contracts, ol.filePath(genesisSmartContractsFileName))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading %s", err, genesisSmartContractsFileName)
+	}
+
+	return contracts, nil
+}
+
+// LoadShardMap will load the optional shard map manifest
+func (ol *outputLoader) LoadShardMap() (*ShardMap, error) {
+	manifest := &ShardMap{}
+	err := core.LoadJsonFile(manifest, ol.filePath(shardMapFileName))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading %s", err, shardMapFileName)
+	}
+
+	return manifest, nil
+}
+
+// LoadWalletMnemonic will load the optional wallet mnemonic file. An encrypted mnemonic is decrypted with the provided
+// keystore passphrase, which is not used for a plaintext mnemonic
+func (ol *outputLoader) LoadWalletMnemonic(passphrase string) (*WalletMnemonic, error) {
+	mnemonic := &WalletMnemonic{}
+	err := core.LoadJsonFile(mnemonic, ol.filePath(walletMnemonicFileName))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading %s", err, walletMnemonicFileName)
+	}
+	if mnemonic.EncryptedMnemonic == nil {
+		return mnemonic, nil
+	}
+
+	mnemonic.Mnemonic, err = deployCore.DecryptMnemonicKeystore(mnemonic.EncryptedMnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decrypting the mnemonic of %s", err, walletMnemonicFileName)
+	}
+
+	return mnemonic, nil
+}
+
+func (ol *outputLoader) filePath(fileName string) string {
+	return filepath.Join(ol.outputDirectory, fileName)
+}
+
+func (ol *outputLoader) directoryExists(directory string) bool {
+	info, err := os.Stat(ol.filePath(directory))
+
+	return err == nil && info.IsDir()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ol *outputLoader) IsInterfaceNil() bool {
+	return ol == nil
+}
@@ -0,0 +1,265 @@
+package plugins
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+const outputWriterParametersSeparator = ":"
+
+// ArgOutputWriter holds the settings shared by all the output writers. The settings specific to a writer are provided
+// as its parameters, the text following the writer name in the <name>[:<parameters>] description
+type ArgOutputWriter struct {
+	OutputDirectory                string
+	ValidatorPubKeyConverter       mxCore.PubkeyConverter
+	WalletPubKeyConverter          mxCore.PubkeyConverter
+	ShardCoordinator               sharding.Coordinator
+	ShouldOutputTxgenAccountsFile  bool
+	ShouldOutputDelegatorsFile     bool
+	ShouldOutputWalletMnemonicFile bool
+	WalletKeyFormat                string
+	KeystorePassphrase             string
+	KeystoreRandReader             io.Reader
+	OutputLayout                   string
+	RoundDuration                  uint64
+	ConsensusGroupSize             int
+	NumOfNodesPerShard             int
+	MetachainConsensusGroupSize    int
+	NumOfMetachainNodes            int
+	NumOfObserversPerShard         int
+	NumOfMetachainObservers        int
+	HysteresisValue                float32
+	AdaptivityValue                bool
+	DelegationServiceFee           uint64
+	DelegationCap                  *big.Int
+	DelegationInitParameters       string
+	DelegationVersion              string
+}
+
+// OutputWriterConstructor creates an output writer out of the common arguments and the parameters of the writer
+type OutputWriterConstructor func(arg ArgOutputWriter, parameters string) (OutputWriter, error)
+
+// OutputWriterDefinition describes a named output writer. The parameters usage is shown next to the writer name, a
+// writer without it accepting no parameters
+type OutputWriterDefinition struct {
+	Name            string
+	Description     string
+	ParametersUsage string
+	Constructor     OutputWriterConstructor
+}
+
+type outputWriterRegistry struct {
+	mut         sync.RWMutex
+	definitions map[string]OutputWriterDefinition
+	names       []string
+}
+
+var writersRegistry = createBuiltinOutputWriterRegistry()
+
+func createBuiltinOutputWriterRegistry() *outputWriterRegistry {
+	builtinRegistry := &outputWriterRegistry{
+		definitions: make(map[string]OutputWriterDefinition),
+		names:       make([]string, 0),
+	}
+
+	builtinDefinitions := []OutputWriterDefinition{
+		{
+			Name:        core.ClassicOutputWriter,
+			Description: "the genesis, nodes setup, shard map, genesis smart contracts and key files",
+			Constructor: classicOutputWriter,
+		},
+		{
+			Name: core.AccountsDumpOutputWriter,
+			Description: "every genesis account as a JSON line, together with its kind and its number of nodes, " +
+				"in the " + defaultAccountsDumpFileName + " file unless another file name is provided",
+			ParametersUsage: "fileName",
+			Constructor:     accountsDumpOutputWriter,
+		},
+	}
+	for _, definition := range builtinDefinitions {
+		_ = builtinRegistry.register(definition)
+	}
+
+	return builtinRegistry
+}
+
+// RegisterOutputWriter will register a named output writer, making its name a valid output writer. It is meant to be
+// called from the init function of the packages defining custom output formats
+func RegisterOutputWriter(definition OutputWriterDefinition) error {
+	return writersRegistry.register(definition)
+}
+
+// RegisteredOutputWriters returns the definitions of the registered output writers, in their registration order
+func RegisteredOutputWriters() []OutputWriterDefinition {
+	return writersRegistry.registeredDefinitions()
+}
+
+// CreateOutputWriter will create an output writer feeding the generated output to all the writers described by the
+// provided <name>[:<parameters>] descriptions, in the provided order. A writer can be enabled only once
+func CreateOutputWriter(descriptions []string, arg ArgOutputWriter) (OutputWriter, error) {
+	return writersRegistry.createOutputWriter(descriptions, arg)
+}
+
+func (reg *outputWriterRegistry) createOutputWriter(descriptions []string, arg ArgOutputWriter) (OutputWriter, error) {
+	if len(descriptions) == 0 {
+		return nil, ErrNoOutputWriter
+	}
+
+	writers := make([]OutputWriter, 0, len(descriptions))
+	closeWriters := func() {
+		for _, writer := range writers {
+			writer.Close()
+		}
+	}
+
+	enabledWriters := make(map[string]struct{})
+	for _, description := range descriptions {
+		definition, parameters, err := reg.get(description)
+		if err != nil {
+			closeWriters()
+			return nil, err
+		}
+
+		_, found := enabledWriters[definition.Name]
+		if found {
+			closeWriters()
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedOutputWriter, definition.Name)
+		}
+		enabledWriters[definition.Name] = struct{}{}
+
+		writer, err := definition.Constructor(arg, parameters)
+		if err != nil {
+			closeWriters()
+			return nil, fmt.Errorf("%w for the %s output writer", err, definition.Name)
+		}
+
+		writers = append(writers, writer)
+	}
+
+	return &multipleOutputWriter{
+		writers: writers,
+	}, nil
+}
+
+func (reg *outputWriterRegistry) register(definition OutputWriterDefinition) error {
+	name := strings.TrimSpace(definition.Name)
+	if len(name) == 0 || strings.Contains(name, outputWriterParametersSeparator) {
+		return fmt.Errorf("%w: %q", ErrInvalidOutputWriterName, definition.Name)
+	}
+	if definition.Constructor == nil {
+		return fmt.Errorf("%w for %s", ErrNilOutputWriterConstructor, name)
+	}
+
+	reg.mut.Lock()
+	defer reg.mut.Unlock()
+
+	_, found := reg.definitions[name]
+	if found {
+		return fmt.Errorf("%w: %s", ErrOutputWriterAlreadyRegistered, name)
+	}
+
+	definition.Name = name
+	reg.definitions[name] = definition
+	reg.names = append(reg.names, name)
+
+	return nil
+}
+
+func (reg *outputWriterRegistry) get(description string) (OutputWriterDefinition, string, error) {
+	name, parameters, hasParameters := strings.Cut(strings.TrimSpace(description), outputWriterParametersSeparator)
+
+	reg.mut.RLock()
+	definition, found := reg.definitions[name]
+	reg.mut.RUnlock()
+	if !found {
+		return OutputWriterDefinition{}, "", fmt.Errorf("%w: %s, the registered writers are %s",
+			ErrUnknownOutputWriter, description, strings.Join(reg.registeredNames(), ", "))
+	}
+	if hasParameters && len(definition.ParametersUsage) == 0 {
+		return OutputWriterDefinition{}, "", fmt.Errorf("%w for %s, the %s writer accepts no parameters",
+			ErrInvalidOutputWriterParameters, description, name)
+	}
+
+	return definition, parameters, nil
+}
+
+func (reg *outputWriterRegistry) registeredDefinitions() []OutputWriterDefinition {
+	reg.mut.RLock()
+	defer reg.mut.RUnlock()
+
+	definitions := make([]OutputWriterDefinition, 0, len(reg.names))
+	for _, name := range reg.names {
+		definitions = append(definitions, reg.definitions[name])
+	}
+
+	return definitions
+}
+
+func (reg *outputWriterRegistry) registeredNames() []string {
+	reg.mut.RLock()
+	defer reg.mut.RUnlock()
+
+	names := make([]string, len(reg.names))
+	copy(names, reg.names)
+
+	return names
+}
+
+func classicOutputWriter(arg ArgOutputWriter, _ string) (OutputWriter, error) {
+	argOutputHandler, err := CreateOutputHandlerArgument(
+		arg.OutputDirectory,
+		arg.ValidatorPubKeyConverter,
+		arg.WalletPubKeyConverter,
+		arg.ShardCoordinator,
+		arg.ShouldOutputTxgenAccountsFile,
+		arg.ShouldOutputDelegatorsFile,
+		arg.ShouldOutputWalletMnemonicFile,
+		arg.WalletKeyFormat,
+		arg.KeystorePassphrase,
+		arg.KeystoreRandReader,
+		arg.OutputLayout,
+	)
+	if err != nil {
+		return nil, err
+	}
+	argOutputHandler.RoundDuration = arg.RoundDuration
+	argOutputHandler.ConsensusGroupSize = arg.ConsensusGroupSize
+	argOutputHandler.NumOfNodesPerShard = arg.NumOfNodesPerShard
+	argOutputHandler.MetachainConsensusGroupSize = arg.MetachainConsensusGroupSize
+	argOutputHandler.NumOfMetachainNodes = arg.NumOfMetachainNodes
+	argOutputHandler.NumOfObserversPerShard = arg.NumOfObserversPerShard
+	argOutputHandler.NumOfMetachainObservers = arg.NumOfMetachainObservers
+	argOutputHandler.HysteresisValue = arg.HysteresisValue
+	argOutputHandler.AdaptivityValue = arg.AdaptivityValue
+	argOutputHandler.DelegationServiceFee = arg.DelegationServiceFee
+	argOutputHandler.DelegationCap = arg.DelegationCap
+	argOutputHandler.DelegationInitParameters = arg.DelegationInitParameters
+	argOutputHandler.DelegationVersion = arg.DelegationVersion
+
+	oh, err := NewOutputHandler(argOutputHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return oh, nil
+}
+
+func accountsDumpOutputWriter(arg ArgOutputWriter, parameters string) (OutputWriter, error) {
+	fileName := strings.TrimSpace(parameters)
+	if len(fileName) == 0 {
+		fileName = defaultAccountsDumpFileName
+	}
+
+	return NewAccountsDumpWriter(ArgAccountsDumpWriter{
+		OutputDirectory:       arg.OutputDirectory,
+		FileName:              fileName,
+		WalletPubKeyConverter: arg.WalletPubKeyConverter,
+	})
+}
@@ -0,0 +1,208 @@
+package plugins
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOutputWriterName = "test-writer"
+
+func createTestOutputWriterDefinition(name string, parametersUsage string) OutputWriterDefinition {
+	return OutputWriterDefinition{
+		Name:            name,
+		Description:     "test description",
+		ParametersUsage: parametersUsage,
+		Constructor: func(arg ArgOutputWriter, parameters string) (OutputWriter, error) {
+			return &mock.OutputWriterStub{}, nil
+		},
+	}
+}
+
+func createTestArgOutputWriter(t *testing.T) ArgOutputWriter {
+	return ArgOutputWriter{
+		OutputDirectory:       t.TempDir(),
+		WalletPubKeyConverter: createTestWalletPubKeyConverter(t),
+	}
+}
+
+func TestOutputWriterRegistry_Register(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinOutputWriterRegistry()
+		err := reg.register(createTestOutputWriterDefinition("", ""))
+		assert.True(t, errors.Is(err, ErrInvalidOutputWriterName))
+	})
+	t.Run("name containing the parameters separator should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinOutputWriterRegistry()
+		err := reg.register(createTestOutputWriterDefinition(testOutputWriterName+":file", ""))
+		assert.True(t, errors.Is(err, ErrInvalidOutputWriterName))
+	})
+	t.Run("nil constructor should error", func(t *testing.T) {
+		t.Parallel()
+
+		definition := createTestOutputWriterDefinition(testOutputWriterName, "")
+		definition.Constructor = nil
+
+		reg := createBuiltinOutputWriterRegistry()
+		err := reg.register(definition)
+		assert.True(t, errors.Is(err, ErrNilOutputWriterConstructor))
+	})
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinOutputWriterRegistry()
+		err := reg.register(createTestOutputWriterDefinition(core.ClassicOutputWriter, ""))
+		assert.True(t, errors.Is(err, ErrOutputWriterAlreadyRegistered))
+	})
+	t.Run("should work and keep the registration order", func(t *testing.T) {
+		t.Parallel()
+
+		reg := createBuiltinOutputWriterRegistry()
+		err := reg.register(createTestOutputWriterDefinition(" "+testOutputWriterName+" ", ""))
+		require.Nil(t, err)
+
+		definitions := reg.registeredDefinitions()
+		require.Equal(t, 3, len(definitions))
+		assert.Equal(t, core.ClassicOutputWriter, definitions[0].Name)
+		assert.Equal(t, core.AccountsDumpOutputWriter, definitions[1].Name)
+		assert.Equal(t, testOutputWriterName, definitions[2].Name)
+
+		// the builtin registry used by the package functions is left untouched
+		assert.Equal(t, 2, len(RegisteredOutputWriters()))
+	})
+}
+
+func TestOutputWriterRegistry_CreateOutputWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no description should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := createBuiltinOutputWriterRegistry().createOutputWriter(nil, createTestArgOutputWriter(t))
+		assert.Nil(t, writer)
+		assert.Equal(t, ErrNoOutputWriter, err)
+	})
+	t.Run("unknown writer should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := createBuiltinOutputWriterRegistry().createOutputWriter(
+			[]string{testOutputWriterName}, createTestArgOutputWriter(t))
+		assert.Nil(t, writer)
+		assert.True(t, errors.Is(err, ErrUnknownOutputWriter))
+		assert.Contains(t, err.Error(), core.ClassicOutputWriter+", "+core.AccountsDumpOutputWriter)
+	})
+	t.Run("parameters on a writer accepting none should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := createBuiltinOutputWriterRegistry().createOutputWriter(
+			[]string{core.ClassicOutputWriter + ":file"}, createTestArgOutputWriter(t))
+		assert.Nil(t, writer)
+		assert.True(t, errors.Is(err, ErrInvalidOutputWriterParameters))
+	})
+	t.Run("duplicated writer should error and close the created writers", func(t *testing.T) {
+		t.Parallel()
+
+		numCloseCalls := 0
+		definition := createTestOutputWriterDefinition(testOutputWriterName, "fileName")
+		definition.Constructor = func(arg ArgOutputWriter, parameters string) (OutputWriter, error) {
+			return &mock.OutputWriterStub{
+				CloseCalled: func() {
+					numCloseCalls++
+				},
+			}, nil
+		}
+
+		reg := createBuiltinOutputWriterRegistry()
+		err := reg.register(definition)
+		require.Nil(t, err)
+
+		descriptions := []string{testOutputWriterName + ":first.jsonl", testOutputWriterName + ":second.jsonl"}
+		writer, err := reg.createOutputWriter(descriptions, createTestArgOutputWriter(t))
+		assert.Nil(t, writer)
+		assert.True(t, errors.Is(err, ErrDuplicatedOutputWriter))
+		assert.Equal(t, 1, numCloseCalls)
+	})
+	t.Run("constructor error should close the created writers", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCloseCalls := 0
+		closingDefinition := createTestOutputWriterDefinition(testOutputWriterName, "")
+		closingDefinition.Constructor = func(arg ArgOutputWriter, parameters string) (OutputWriter, error) {
+			return &mock.OutputWriterStub{
+				CloseCalled: func() {
+					numCloseCalls++
+				},
+			}, nil
+		}
+		failingDefinition := createTestOutputWriterDefinition("failing-writer", "")
+		failingDefinition.Constructor = func(arg ArgOutputWriter, parameters string) (OutputWriter, error) {
+			return nil, expectedErr
+		}
+
+		reg := createBuiltinOutputWriterRegistry()
+		require.Nil(t, reg.register(closingDefinition))
+		require.Nil(t, reg.register(failingDefinition))
+
+		writer, err := reg.createOutputWriter(
+			[]string{testOutputWriterName, "failing-writer"}, createTestArgOutputWriter(t))
+		assert.Nil(t, writer)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Contains(t, err.Error(), "failing-writer")
+		assert.Equal(t, 1, numCloseCalls)
+	})
+	t.Run("should pass the parameters to the constructor", func(t *testing.T) {
+		t.Parallel()
+
+		providedParameters := make([]string, 0)
+		definition := createTestOutputWriterDefinition(testOutputWriterName, "fileName")
+		definition.Constructor = func(arg ArgOutputWriter, parameters string) (OutputWriter, error) {
+			providedParameters = append(providedParameters, parameters)
+			return &mock.OutputWriterStub{}, nil
+		}
+
+		reg := createBuiltinOutputWriterRegistry()
+		err := reg.register(definition)
+		require.Nil(t, err)
+
+		writer, err := reg.createOutputWriter(
+			[]string{" " + testOutputWriterName + ":dir/file:name.jsonl "}, createTestArgOutputWriter(t))
+		require.Nil(t, err)
+		assert.False(t, writer.IsInterfaceNil())
+		assert.Equal(t, []string{"dir/file:name.jsonl"}, providedParameters)
+	})
+	t.Run("accounts dump writer should use the provided file name", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createTestArgOutputWriter(t)
+		writer, err := createBuiltinOutputWriterRegistry().createOutputWriter(
+			[]string{core.AccountsDumpOutputWriter + ":dump.jsonl"}, arg)
+		require.Nil(t, err)
+		writer.Close()
+
+		assert.FileExists(t, filepath.Join(arg.OutputDirectory, "dump.jsonl"))
+		assert.NoFileExists(t, filepath.Join(arg.OutputDirectory, defaultAccountsDumpFileName))
+	})
+	t.Run("accounts dump writer should default the file name", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createTestArgOutputWriter(t)
+		writer, err := createBuiltinOutputWriterRegistry().createOutputWriter(
+			[]string{core.AccountsDumpOutputWriter}, arg)
+		require.Nil(t, err)
+		writer.Close()
+
+		assert.FileExists(t, filepath.Join(arg.OutputDirectory, defaultAccountsDumpFileName))
+	})
+}
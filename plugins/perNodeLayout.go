@@ -0,0 +1,69 @@
+package plugins
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	mxCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/core"
+	"github.com/multiversx/mx-chain-deploy-go/data"
+)
+
+const nodeConfigDirectory = "config"
+const metachainDirectoryName = "metachain"
+
+// writePerNodeValidatorKeys will write the key of each validator and observer in its own
+// node-<shard>-<index>/config/validatorKey.pem file, ready to be mounted in the node's container
+func (oh *outputHandler) writePerNodeValidatorKeys(
+	generatedOutput data.GeneratorOutput,
+	assignments []*core.NodeAssignment,
+) error {
+	secretKeys := make(map[string][]byte)
+	for _, key := range append(generatedOutput.ValidatorBlsKeys, generatedOutput.ObserverBlsKeys...) {
+		pkString, _ := oh.validatorPubKeyConverter.Encode(key.PubKeyBytes)
+		secretKeys[pkString] = key.PrivKeyBytes
+	}
+
+	for _, assignment := range assignments {
+		skBytes := secretKeys[assignment.PubKey]
+		if len(skBytes) == 0 {
+			log.Debug("skipping validator key as its secret key is not known", "pk", assignment.PubKey)
+			continue
+		}
+
+		err := oh.writeNodeValidatorKey(assignment, skBytes)
+		if err != nil {
+			return fmt.Errorf("%w for pk %s", err, assignment.PubKey)
+		}
+	}
+
+	log.Info("per node layout written", "num nodes", len(assignments))
+
+	return nil
+}
+
+func (oh *outputHandler) writeNodeValidatorKey(assignment *core.NodeAssignment, skBytes []byte) error {
+	nodeDirectory := filepath.Join(oh.outputDirectory, nodeDirectoryName(assignment), nodeConfigDirectory)
+	err := os.MkdirAll(nodeDirectory, 0755)
+	if err != nil {
+		return err
+	}
+
+	fileHandler, err := core.NewSecretFileHandler(nodeDirectory, validatorKeyFileName)
+	if err != nil {
+		return err
+	}
+	defer fileHandler.Close()
+
+	return fileHandler.SaveSkToPemFile(assignment.PubKey, skBytes)
+}
+
+func nodeDirectoryName(assignment *core.NodeAssignment) string {
+	shardName := fmt.Sprintf("%d", assignment.ShardID)
+	if assignment.ShardID == mxCore.MetachainShardId {
+		shardName = metachainDirectoryName
+	}
+
+	return fmt.Sprintf("node-%s-%d", shardName, assignment.Index)
+}
@@ -0,0 +1,16 @@
+package plugins
+
+// ShardMapNode holds the assignment of one BLS key, as found in the shard map manifest
+type ShardMapNode struct {
+	PubKey  string `json:"pubKey"`
+	ShardID uint32 `json:"shardId"`
+	Role    string `json:"role"`
+	Owner   string `json:"owner,omitempty"`
+	Index   uint32 `json:"index"`
+}
+
+// ShardMap is the shard map manifest containing the assignment of every BLS key
+type ShardMap struct {
+	NumOfShards uint32          `json:"numOfShards"`
+	Nodes       []*ShardMapNode `json:"nodes"`
+}
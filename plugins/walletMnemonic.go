@@ -0,0 +1,19 @@
+package plugins
+
+import "github.com/multiversx/mx-chain-deploy-go/core"
+
+// WalletMnemonicAccount holds the derivation index of one wallet key, as found in the wallet mnemonic file
+type WalletMnemonicAccount struct {
+	Address string `json:"address"`
+	Index   uint32 `json:"index"`
+	Kind    string `json:"kind"`
+}
+
+// WalletMnemonic is the wallet mnemonic file content. The mnemonic is stored either as plaintext or, when the wallet
+// keys are written as keystores, as a keystore of the mnemonic kind encrypted with the same passphrase
+type WalletMnemonic struct {
+	Mnemonic          string                   `json:"mnemonic,omitempty"`
+	EncryptedMnemonic *core.EncryptedKeyJSON   `json:"encryptedMnemonic,omitempty"`
+	DerivationPath    string                   `json:"derivationPath"`
+	Accounts          []*WalletMnemonicAccount `json:"accounts"`
+}